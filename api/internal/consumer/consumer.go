@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	sync "sync"
+	"time"
 
 	"mosn.io/htnn/api/internal/proto"
 	csModel "mosn.io/htnn/api/pkg/consumer/model"
@@ -93,11 +94,20 @@ func (c *Consumer) InitConfigs() error {
 			return fmt.Errorf("%w during parsing plugin %s in consumer", err, name)
 		}
 
+		var timeout time.Duration
+		if data.Timeout != "" {
+			timeout, err = time.ParseDuration(data.Timeout)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("invalid timeout %q of plugin %s in consumer", data.Timeout, name)
+			}
+		}
+
 		c.FilterConfigs[name] = &fmModel.ParsedFilterConfig{
 			Name:          name,
 			ParsedConfig:  conf,
 			Factory:       p.Factory,
 			SyncRunPhases: p.ConfigParser.NonBlockingPhases(),
+			Timeout:       timeout,
 		}
 	}
 
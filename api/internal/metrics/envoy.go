@@ -0,0 +1,37 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !envoy1.29
+
+package metrics
+
+import (
+	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
+)
+
+type envoyBackend struct {
+	callbacks capi.ConfigCallbackHandler
+}
+
+func newEnvoyBackend(cb capi.ConfigCallbackHandler) backend {
+	return &envoyBackend{callbacks: cb}
+}
+
+func (b *envoyBackend) defineCounter(name string) cell {
+	return b.callbacks.DefineCounterMetric(name)
+}
+
+func (b *envoyBackend) defineGauge(name string) cell {
+	return b.callbacks.DefineGaugeMetric(name)
+}
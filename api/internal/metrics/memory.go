@@ -0,0 +1,36 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+type memoryCell struct {
+	value atomic.Uint64
+}
+
+func (c *memoryCell) Increment(offset int64) {
+	// adding a negative offset is done via the two's complement
+	c.value.Add(uint64(offset))
+}
+
+func (c *memoryCell) Get() uint64 {
+	return c.value.Load()
+}
+
+func (c *memoryCell) Record(value uint64) {
+	c.value.Store(value)
+}
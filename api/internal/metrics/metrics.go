@@ -0,0 +1,259 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
+)
+
+// Tag is a key-value pair attached to the metric.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+type Counter interface {
+	// Increment adds the offset to the counter.
+	Increment(offset int64)
+	// Get returns the current value.
+	Get() uint64
+	// WithTags returns the counter which has the given tags.
+	WithTags(tags ...Tag) Counter
+}
+
+type Gauge interface {
+	// Increment adds the offset to the gauge. The offset can be negative.
+	Increment(offset int64)
+	// Record sets the gauge to the value.
+	Record(value uint64)
+	// Get returns the current value.
+	Get() uint64
+	// WithTags returns the gauge which has the given tags.
+	WithTags(tags ...Tag) Gauge
+}
+
+type metricType int
+
+const (
+	metricTypeCounter metricType = iota
+	metricTypeGauge
+)
+
+// cell is the storage of a metric. Both the Envoy's CounterMetric and GaugeMetric
+// satisfy this interface.
+type cell interface {
+	Increment(offset int64)
+	Get() uint64
+	Record(value uint64)
+}
+
+// backend defines the metric in the underlying stats system.
+type backend interface {
+	defineCounter(name string) cell
+	defineGauge(name string) cell
+}
+
+type metricKey struct {
+	typ  metricType
+	name string
+}
+
+type registry struct {
+	lock sync.Mutex
+	// generation is increased each time the backend is changed, so that the metric
+	// can know it needs to be defined again.
+	generation atomic.Uint64
+	backend    backend
+	cells      map[metricKey]cell
+	handles    sync.Map
+}
+
+var defaultRegistry = newRegistry()
+
+func newRegistry() *registry {
+	return &registry{
+		cells: make(map[metricKey]cell),
+	}
+}
+
+// setBackend sets the backend used to define the new metrics. The metrics are defined once per
+// process, so the defined cells are kept when the backend is updated. Only the first backend
+// replaces the cells stored in memory.
+func (r *registry) setBackend(b backend) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	first := r.backend == nil
+	r.backend = b
+	if first {
+		r.cells = make(map[metricKey]cell)
+		r.generation.Add(1)
+	}
+}
+
+func (r *registry) define(typ metricType, name string) (cell, uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	gen := r.generation.Load()
+	key := metricKey{typ: typ, name: name}
+	if c, ok := r.cells[key]; ok {
+		return c, gen
+	}
+
+	var c cell
+	if r.backend != nil {
+		switch typ {
+		case metricTypeCounter:
+			c = r.backend.defineCounter(name)
+		case metricTypeGauge:
+			c = r.backend.defineGauge(name)
+		}
+	}
+	if c == nil {
+		c = &memoryCell{}
+	}
+	r.cells[key] = c
+	return c, gen
+}
+
+// SetConfigCallbacks uses Envoy's stats as the backend. Before it's called, or the Envoy
+// doesn't support defining metrics in Go, the metrics are stored in memory.
+// It's called each time the configuration is parsed. The latest callbacks are used to define
+// the new metrics, while the defined metrics are kept, so their values are not reset.
+func SetConfigCallbacks(cb capi.ConfigCallbackHandler) {
+	if cb == nil {
+		return
+	}
+	b := newEnvoyBackend(cb)
+	if b == nil {
+		return
+	}
+	defaultRegistry.setBackend(b)
+}
+
+type resolvedCell struct {
+	generation uint64
+	cell       cell
+}
+
+type metric struct {
+	typ      metricType
+	name     string
+	registry *registry
+	resolved atomic.Pointer[resolvedCell]
+}
+
+func (m *metric) cell() cell {
+	r := m.resolved.Load()
+	if r != nil && r.generation == m.registry.generation.Load() {
+		return r.cell
+	}
+
+	c, gen := m.registry.define(m.typ, m.name)
+	m.resolved.Store(&resolvedCell{generation: gen, cell: c})
+	return c
+}
+
+// nameWithTags appends the tags to the name like `name.key1.value1.key2.value2`. The tags are
+// sorted by key, so the same set of tags always produce the same name. The tags can be
+// extracted via Envoy's `stats_config.stats_tags`.
+func nameWithTags(name string, tags []Tag) string {
+	if len(tags) == 0 {
+		return name
+	}
+
+	sorted := make([]Tag, len(tags))
+	copy(sorted, tags)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+
+	var sb strings.Builder
+	sb.WriteString(name)
+	for _, tag := range sorted {
+		sb.WriteByte('.')
+		sb.WriteString(sanitize(tag.Key))
+		sb.WriteByte('.')
+		sb.WriteString(sanitize(tag.Value))
+	}
+	return sb.String()
+}
+
+func sanitize(s string) string {
+	return strings.ReplaceAll(s, ".", "_")
+}
+
+func (r *registry) handle(typ metricType, name string) *metric {
+	key := metricKey{typ: typ, name: name}
+	if m, ok := r.handles.Load(key); ok {
+		return m.(*metric)
+	}
+	m, _ := r.handles.LoadOrStore(key, &metric{
+		typ:      typ,
+		name:     name,
+		registry: r,
+	})
+	return m.(*metric)
+}
+
+type counter struct {
+	*metric
+}
+
+func (c *counter) Increment(offset int64) {
+	c.cell().Increment(offset)
+}
+
+func (c *counter) Get() uint64 {
+	return c.cell().Get()
+}
+
+func (c *counter) WithTags(tags ...Tag) Counter {
+	return &counter{c.registry.handle(metricTypeCounter, nameWithTags(c.name, tags))}
+}
+
+type gauge struct {
+	*metric
+}
+
+func (g *gauge) Increment(offset int64) {
+	g.cell().Increment(offset)
+}
+
+func (g *gauge) Record(value uint64) {
+	g.cell().Record(value)
+}
+
+func (g *gauge) Get() uint64 {
+	return g.cell().Get()
+}
+
+func (g *gauge) WithTags(tags ...Tag) Gauge {
+	return &gauge{g.registry.handle(metricTypeGauge, nameWithTags(g.name, tags))}
+}
+
+func DefineCounter(name string) Counter {
+	return &counter{defaultRegistry.handle(metricTypeCounter, name)}
+}
+
+func DefineGauge(name string) Gauge {
+	return &gauge{defaultRegistry.handle(metricTypeGauge, name)}
+}
@@ -0,0 +1,91 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	c := DefineCounter("test.counter")
+	c.Increment(1)
+	c.Increment(2)
+	assert.Equal(t, uint64(3), c.Get())
+	assert.Equal(t, uint64(3), DefineCounter("test.counter").Get())
+
+	tagged := c.WithTags(Tag{Key: "plugin", Value: "keyAuth"}, Tag{Key: "code", Value: "401"})
+	tagged.Increment(1)
+	assert.Equal(t, uint64(1), tagged.Get())
+	// the order of tags doesn't matter
+	assert.Equal(t, uint64(1), c.WithTags(Tag{Key: "code", Value: "401"}, Tag{Key: "plugin", Value: "keyAuth"}).Get())
+	assert.Equal(t, uint64(3), c.Get())
+}
+
+func TestGauge(t *testing.T) {
+	g := DefineGauge("test.gauge")
+	g.Record(10)
+	g.Increment(-3)
+	assert.Equal(t, uint64(7), g.Get())
+
+	// gauge and counter with the same name are different metrics
+	assert.Equal(t, uint64(0), DefineCounter("test.gauge").Get())
+}
+
+func TestNameWithTags(t *testing.T) {
+	assert.Equal(t, "name", nameWithTags("name", nil))
+	assert.Equal(t, "name.a.1.b.v1_2", nameWithTags("name", []Tag{
+		{Key: "b", Value: "v1.2"},
+		{Key: "a", Value: "1"},
+	}))
+}
+
+type fakeBackend struct {
+	defined []string
+}
+
+func (b *fakeBackend) defineCounter(name string) cell {
+	b.defined = append(b.defined, name)
+	return &memoryCell{}
+}
+
+func (b *fakeBackend) defineGauge(name string) cell {
+	b.defined = append(b.defined, name)
+	return &memoryCell{}
+}
+
+func TestSwitchBackend(t *testing.T) {
+	r := newRegistry()
+	c := &counter{r.handle(metricTypeCounter, "counter")}
+	c.Increment(1)
+	assert.Equal(t, uint64(1), c.Get())
+
+	b := &fakeBackend{}
+	r.setBackend(b)
+	c.Increment(2)
+	assert.Equal(t, uint64(2), c.Get())
+	c.WithTags(Tag{Key: "k", Value: "v"}).Increment(1)
+	assert.Equal(t, []string{"counter", "counter.k.v"}, b.defined)
+
+	// the defined metrics are kept when the backend is updated
+	b2 := &fakeBackend{}
+	r.setBackend(b2)
+	c.Increment(1)
+	assert.Equal(t, uint64(3), c.Get())
+	(&counter{r.handle(metricTypeCounter, "counter2")}).Increment(1)
+	assert.Equal(t, []string{"counter2"}, b2.defined)
+	assert.Equal(t, []string{"counter", "counter.k.v"}, b.defined)
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -231,6 +232,12 @@ type StreamFilterCallbacks interface {
 	// PluginState returns the PluginState associated to this request.
 	PluginState() PluginState
 
+	// Context returns the context of the current plugin. If the plugin is configured with a timeout,
+	// the context carries the deadline of the running phase and is cancelled once the deadline is
+	// exceeded. Plugins which make outbound calls should pass it down so the calls can be aborted
+	// in time. Otherwise, a context without deadline is returned.
+	Context() context.Context
+
 	// WithLogArg injectes `key: value` as the suffix of application log created by this
 	// callback's Log* methods. The injected log arguments are only valid in the current request.
 	// This method can be used to inject IDs or other context information into the logs.
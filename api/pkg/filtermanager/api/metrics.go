@@ -0,0 +1,47 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"mosn.io/htnn/api/internal/metrics"
+)
+
+// The metrics are backed by Envoy's stats when the Envoy supports defining metrics in Go.
+// Otherwise, they are stored in memory. As Envoy doesn't support defining histograms in Go yet,
+// there is no histogram. To export a latency, record its total in a counter, and compute the
+// average with the counter of the invocations.
+//
+// The tags are appended to the metric name like `name.key1.value1.key2.value2`, sorted by key.
+// Use Envoy's `stats_config.stats_tags` to extract them as the labels in Prometheus.
+
+// MetricTag is a key-value pair attached to the metric
+type MetricTag = metrics.Tag
+
+// Counter is a metric which can only be increased
+type Counter = metrics.Counter
+
+// Gauge is a metric which can be increased, decreased and set
+type Gauge = metrics.Gauge
+
+// DefineCounter defines a counter with the given name. Metrics with the same name and type are
+// shared. It's recommended to define the metric once, for example, as a package level variable.
+func DefineCounter(name string) Counter {
+	return metrics.DefineCounter(name)
+}
+
+// DefineGauge defines a gauge with the given name. Metrics with the same name and type are shared.
+func DefineGauge(name string) Gauge {
+	return metrics.DefineGauge(name)
+}
@@ -66,3 +66,20 @@ type LocalResponse struct {
 type DefaultJSONResponse struct {
 	Msg string `json:"msg"`
 }
+
+// TransformBody can be returned from DecodeHeaders / EncodeHeaders to rewrite the body
+// streamingly. Unlike WaitAllData, the body is not buffered. Each piece of the body is passed
+// to the Transformer after the DecodeData / EncodeData of the plugin is called, and the
+// result is handed to the next plugin. The `content-length` header will be removed as
+// the size of the body may be changed.
+type TransformBody struct {
+	isResultAction
+
+	Transformer BodyTransformer
+	// MaxLookahead limits the size of data held back by the Transformer between two pieces
+	// of the body. If the limit is exceeded, the request will be terminated with 500 status code.
+	// Default to DefaultMaxLookahead.
+	MaxLookahead int
+}
+
+const DefaultMaxLookahead = 64 * 1024
@@ -0,0 +1,46 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// BodyTransformer rewrites the body piece by piece. It is bound to a single request, so it can
+// keep the parsing state between calls. See TransformBody for how to use it.
+type BodyTransformer interface {
+	// Transform is called with the data held back from the previous call, followed by the
+	// newly received piece of the body. It returns the output which will replace the piece,
+	// and the tail of data which can't be processed yet (for example, an incomplete line
+	// or SSE event). The returned rest is kept and passed back at the next call.
+	Transform(data []byte) (output []byte, rest []byte)
+	// Flush is called once when the body is finished, with the data still held back.
+	// The returned data is appended to the end of the body.
+	Flush(rest []byte) []byte
+}
+
+// BodyTransformerFuncs adapts a pair of functions to the BodyTransformer interface.
+// A nil FlushFunc returns the held back data unchanged.
+type BodyTransformerFuncs struct {
+	TransformFunc func(data []byte) (output []byte, rest []byte)
+	FlushFunc     func(rest []byte) []byte
+}
+
+func (t *BodyTransformerFuncs) Transform(data []byte) ([]byte, []byte) {
+	return t.TransformFunc(data)
+}
+
+func (t *BodyTransformerFuncs) Flush(rest []byte) []byte {
+	if t.FlushFunc == nil {
+		return rest
+	}
+	return t.FlushFunc(rest)
+}
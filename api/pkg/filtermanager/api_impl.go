@@ -15,6 +15,7 @@
 package filtermanager
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 	"mosn.io/htnn/api/internal/cookie"
 	"mosn.io/htnn/api/internal/pluginstate"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/tracing"
 )
 
 type filterManagerRequestHeaderMap struct {
@@ -151,6 +153,9 @@ type filterManagerCallbackHandler struct {
 
 	logArgNames string
 	logArgs     []any
+
+	// the span of the running plugin
+	span *tracing.Span
 }
 
 func (cb *filterManagerCallbackHandler) Reset() {
@@ -164,6 +169,7 @@ func (cb *filterManagerCallbackHandler) Reset() {
 	cb.streamInfo = nil
 	cb.logArgNames = ""
 	cb.logArgs = nil
+	cb.span = nil
 
 	cb.cacheLock.Unlock()
 }
@@ -207,6 +213,24 @@ func (cb *filterManagerCallbackHandler) PluginState() api.PluginState {
 	return cb.pluginState
 }
 
+func (cb *filterManagerCallbackHandler) Context() context.Context {
+	// Only the plugin configured with timeout has a context with deadline
+	ctx := context.Background()
+	cb.cacheLock.Lock()
+	span := cb.span
+	cb.cacheLock.Unlock()
+	if span != nil {
+		ctx = tracing.ContextWithSpan(ctx, span)
+	}
+	return ctx
+}
+
+func (cb *filterManagerCallbackHandler) setSpan(span *tracing.Span) {
+	cb.cacheLock.Lock()
+	cb.span = span
+	cb.cacheLock.Unlock()
+}
+
 func (cb *filterManagerCallbackHandler) WithLogArg(key string, value any) api.StreamFilterCallbacks {
 	// As the log is embedded into the Envoy's log, it's not so necessary to use structural logging
 	// here. So far the value is just an ID string, introduce complex processions like quoting is
@@ -21,11 +21,13 @@ import (
 	"reflect"
 	"sort"
 	"sync"
+	"time"
 
 	xds "github.com/cncf/xds/go/xds/type/v3"
 	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"mosn.io/htnn/api/internal/metrics"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/filtermanager/model"
 	pkgPlugins "mosn.io/htnn/api/pkg/plugins"
@@ -44,6 +46,9 @@ type FilterManagerConfig struct {
 	Namespace string `json:"namespace,omitempty"`
 
 	Plugins []*model.FilterConfig `json:"plugins"`
+
+	// PluginTimeout controls the behavior when a plugin exceeds its timeout
+	PluginTimeout *model.PluginTimeoutConfig `json:"pluginTimeout,omitempty"`
 }
 
 type filterManagerConfig struct {
@@ -60,6 +65,10 @@ type filterManagerConfig struct {
 	namespace string
 
 	enableDebugMode bool
+
+	pluginTimeout *model.PluginTimeoutConfig
+
+	hasMatcher bool
 }
 
 func initFilterManagerConfig(namespace string) *filterManagerConfig {
@@ -106,6 +115,13 @@ func (conf *filterManagerConfig) Merge(another *filterManagerConfig) *filterMana
 		cp.enableDebugMode = true
 	}
 
+	cp.pluginTimeout = conf.pluginTimeout
+	if cp.pluginTimeout == nil {
+		cp.pluginTimeout = another.pluginTimeout
+	}
+
+	cp.hasMatcher = conf.hasMatcher || another.hasMatcher
+
 	cp.parsed = make([]*model.ParsedFilterConfig, 0, len(conf.parsed)+len(another.parsed))
 	// For now, we don't deepcopy the config. The config may contain connection to the external
 	// service, for example, a Redis cluster. Not sure if it is safe to deepcopy them. So far,
@@ -177,6 +193,10 @@ func (conf *filterManagerConfig) InitOnce() {
 }
 
 func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigCallbackHandler) (interface{}, error) {
+	// The callbacks are only available when parsing the HTTP filter level configuration.
+	// Use them to define the metrics in Envoy.
+	metrics.SetConfigCallbacks(callbacks)
+
 	configStruct := &xds.TypedStruct{}
 
 	// No configuration
@@ -209,6 +229,7 @@ func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigC
 	plugins := fmConfig.Plugins
 	conf := initFilterManagerConfig(fmConfig.Namespace)
 	conf.parsed = make([]*model.ParsedFilterConfig, 0, len(plugins))
+	conf.pluginTimeout = fmConfig.PluginTimeout
 
 	consumerFiltersEndAt := 0
 	i := 0
@@ -218,6 +239,14 @@ func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigC
 		name := proto.Name
 		if plugin := pkgPlugins.LoadHTTPFilterFactoryAndParser(name); plugin != nil {
 			config, err := plugin.ConfigParser.Parse(proto.Config)
+			var timeout time.Duration
+			if err == nil && proto.Timeout != "" {
+				timeout, err = parsePluginTimeout(proto.Timeout)
+			}
+			var matcher model.PluginMatcher
+			if err == nil && proto.Match != "" {
+				matcher, err = compilePluginMatcher(proto.Match)
+			}
 			if err != nil {
 				api.LogErrorf("%s during parsing plugin %s in filtermanager", err, name)
 
@@ -236,8 +265,14 @@ func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigC
 					ParsedConfig:  config,
 					Factory:       plugin.Factory,
 					SyncRunPhases: plugin.ConfigParser.NonBlockingPhases(),
+					Timeout:       timeout,
+					Matcher:       matcher,
 				})
 
+				if matcher != nil {
+					conf.hasMatcher = true
+				}
+
 				_, ok := pkgPlugins.LoadPlugin(name).(pkgPlugins.ConsumerPlugin)
 				if ok {
 					consumerFiltersEndAt = i + 1
@@ -276,6 +311,17 @@ func (p *FilterManagerConfigParser) Parse(any *anypb.Any, callbacks capi.ConfigC
 	return conf, nil
 }
 
+func parsePluginTimeout(s string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", s, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q: should be positive", s)
+	}
+	return timeout, nil
+}
+
 func (p *FilterManagerConfigParser) Merge(parent interface{}, child interface{}) interface{} {
 	httpFilterCfg, ok := parent.(*filterManagerConfig)
 	if !ok {
@@ -16,6 +16,7 @@ package filtermanager
 
 import (
 	"testing"
+	"time"
 
 	xds "github.com/cncf/xds/go/xds/type/v3"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,8 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"mosn.io/htnn/api/internal/proto"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+	"mosn.io/htnn/api/pkg/plugins"
 )
 
 func TestParse(t *testing.T) {
@@ -81,3 +84,112 @@ func TestMergeDebugFlag(t *testing.T) {
 	merged = parent.Merge(child)
 	assert.Equal(t, true, merged.enableDebugMode)
 }
+
+func TestParsePluginTimeout(t *testing.T) {
+	plugins.RegisterPlugin("timeout", &plugins.MockPlugin{})
+
+	cases := []struct {
+		name    string
+		timeout string
+		expect  time.Duration
+		bad     bool
+	}{
+		{
+			name:    "happy path",
+			timeout: "100ms",
+			expect:  100 * time.Millisecond,
+		},
+		{
+			name: "no timeout",
+		},
+		{
+			name:    "invalid",
+			timeout: "100",
+			bad:     true,
+		},
+		{
+			name:    "negative",
+			timeout: "-1s",
+			bad:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ts := xds.TypedStruct{}
+			ts.Value, _ = structpb.NewStruct(map[string]interface{}{
+				"plugins": []interface{}{
+					map[string]interface{}{
+						"name":    "timeout",
+						"timeout": c.timeout,
+					},
+				},
+				"pluginTimeout": map[string]interface{}{
+					"failOpen": true,
+				},
+			})
+			parser := &FilterManagerConfigParser{}
+			res, err := parser.Parse(proto.MessageToAny(&ts), nil)
+			assert.Nil(t, err)
+
+			conf := res.(*filterManagerConfig)
+			assert.True(t, conf.pluginTimeout.FailOpen)
+			fc := conf.parsed[0]
+			if c.bad {
+				// an error filter is used
+				assert.Nil(t, fc.ParsedConfig)
+			} else {
+				assert.NotNil(t, fc.ParsedConfig)
+				assert.Equal(t, c.expect, fc.Timeout)
+			}
+		})
+	}
+}
+
+func TestMergePluginTimeout(t *testing.T) {
+	parent := initFilterManagerConfig("")
+	child := initFilterManagerConfig("")
+	child.pluginTimeout = &model.PluginTimeoutConfig{StatusCode: 503}
+	merged := parent.Merge(child)
+	assert.Equal(t, 503, merged.pluginTimeout.StatusCode)
+
+	parent.pluginTimeout = &model.PluginTimeoutConfig{StatusCode: 504}
+	merged = parent.Merge(child)
+	assert.Equal(t, 504, merged.pluginTimeout.StatusCode)
+}
+
+func TestParsePluginMatch(t *testing.T) {
+	plugins.RegisterPlugin("match", &plugins.MockPlugin{})
+
+	parse := func() *filterManagerConfig {
+		ts := xds.TypedStruct{}
+		ts.Value, _ = structpb.NewStruct(map[string]interface{}{
+			"plugins": []interface{}{
+				map[string]interface{}{
+					"name":  "match",
+					"match": "/admin",
+				},
+			},
+		})
+		parser := &FilterManagerConfigParser{}
+		res, err := parser.Parse(proto.MessageToAny(&ts), nil)
+		assert.Nil(t, err)
+		return res.(*filterManagerConfig)
+	}
+
+	// no compiler registered, an error filter is used
+	conf := parse()
+	assert.Nil(t, conf.parsed[0].ParsedConfig)
+	assert.False(t, conf.hasMatcher)
+
+	RegisterPluginMatcherCompiler(func(expr string) (model.PluginMatcher, error) {
+		return &pathPrefixMatcher{prefix: expr}, nil
+	})
+	defer RegisterPluginMatcherCompiler(nil)
+
+	conf = parse()
+	assert.NotNil(t, conf.parsed[0].ParsedConfig)
+	assert.Equal(t, &pathPrefixMatcher{prefix: "/admin"}, conf.parsed[0].Matcher)
+	assert.True(t, conf.hasMatcher)
+	assert.True(t, initFilterManagerConfig("").Merge(conf).hasMatcher)
+}
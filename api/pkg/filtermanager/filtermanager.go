@@ -30,6 +30,7 @@ import (
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/filtermanager/model"
 	pkgPlugins "mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/api/pkg/tracing"
 )
 
 type filterManager struct {
@@ -37,8 +38,14 @@ type filterManager struct {
 
 	decodeRequestNeeded bool
 	decodeIdx           int
-	reqHdr              api.RequestHeaderMap // don't access it in Encode phases
-	reqBuf              capi.BufferInstance  // don't access it in Encode phases
+	// The consumer filters (Access & Authn plugins) which return WaitAllData. As the consumer is
+	// determined by the headers, the plugins from the consumer are merged before the body is
+	// received. The plugins after the first waiting filter, including the merged ones, are run
+	// once the waiting filters verify the whole request in DecodeRequest. The DecodeHeaders of
+	// the consumer filters are not run again.
+	consumerFiltersWaitingBody []*model.FilterWrapper
+	reqHdr                     api.RequestHeaderMap // don't access it in Encode phases
+	reqBuf                     capi.BufferInstance  // don't access it in Encode phases
 
 	encodeResponseNeeded bool
 	encodeWaitFirstData  bool
@@ -46,6 +53,16 @@ type filterManager struct {
 	rspHdr               api.ResponseHeaderMap
 	rspBuf               capi.BufferInstance
 
+	// transformers installed via api.TransformBody
+	decodeTransformers map[*model.FilterWrapper]*bodyTransformer
+	encodeTransformers map[*model.FilterWrapper]*bodyTransformer
+
+	// the span context extracted from the request headers, used as the parent of plugin spans
+	tracingEnabled bool
+	traceParent    tracing.SpanContext
+	// the root span started for the request without trace context, ended in the OnLog phase
+	requestSpan *tracing.Span
+
 	runningInGoThread atomic.Int32
 	hdrLock           sync.Mutex
 
@@ -78,6 +95,7 @@ func (m *filterManager) Reset() {
 
 	m.decodeRequestNeeded = false
 	m.decodeIdx = -1
+	m.consumerFiltersWaitingBody = nil
 	m.reqHdr = nil
 	m.reqBuf = nil
 
@@ -87,6 +105,13 @@ func (m *filterManager) Reset() {
 	m.rspHdr = nil
 	m.rspBuf = nil
 
+	m.decodeTransformers = nil
+	m.encodeTransformers = nil
+
+	m.tracingEnabled = false
+	m.endRequestSpan()
+	m.traceParent = tracing.SpanContext{}
+
 	m.runningInGoThread.Store(0) // defence in depth
 
 	m.canSkipDecodeHeaders = false
@@ -164,10 +189,18 @@ func FilterManagerFactory(c interface{}, cb capi.FilterCallbackHandler) (streamF
 
 	filters := make([]*model.FilterWrapper, len(parsedConfig))
 	logExecution := needLogExecution()
+	tracingEnabled := tracing.Enabled()
+	fm.tracingEnabled = tracingEnabled
 	for i, fc := range parsedConfig {
 		factory := fc.Factory
 		config := fc.ParsedConfig
-		f := factory(config, fm.callbacks)
+		var cb api.FilterCallbackHandler = fm.callbacks
+		var timeoutCb *timeoutCallbackHandler
+		if fc.Timeout > 0 {
+			timeoutCb = newTimeoutCallbackHandler(fm.callbacks)
+			cb = timeoutCb
+		}
+		f := factory(config, cb)
 		// Technically, the factory might create different f for different calls. We don't support this edge case for now.
 		if fm.canSkipMethods == nil {
 			definedMethod := make(map[string]bool, len(canSkipMethods))
@@ -188,26 +221,30 @@ func FilterManagerFactory(c interface{}, cb capi.FilterCallbackHandler) (streamF
 				}
 			}
 
-			if definedMethod["DecodeRequest"] {
-				if !definedMethod["DecodeHeaders"] {
-					api.LogErrorf("plugin %s has DecodeRequest but not DecodeHeaders. To run DecodeRequest, we need to return api.WaitAllData from DecodeHeaders", fc.Name)
-				}
-
-				if conf.consumerFiltersEndAt != 0 {
-					p := pkgPlugins.LoadPluginType(fc.Name)
-					if p != nil {
-						order := p.Order()
-						if order.Position <= pkgPlugins.OrderPositionAuthn {
-							api.LogErrorf("plugin %s has DecodeRequest which is not supported because the order of plugin", fc.Name)
-						}
-					}
-				}
+			if definedMethod["DecodeRequest"] && !definedMethod["DecodeHeaders"] {
+				api.LogErrorf("plugin %s has DecodeRequest but not DecodeHeaders. To run DecodeRequest, we need to return api.WaitAllData from DecodeHeaders", fc.Name)
 			}
 			if definedMethod["EncodeResponse"] && !definedMethod["EncodeHeaders"] {
 				api.LogErrorf("plugin %s has EncodeResponse but not EncodeHeaders. To run EncodeResponse, we need to return api.WaitAllData from EncodeHeaders", fc.Name)
 			}
 		}
 
+		if timeoutCb != nil {
+			f = NewTimeoutFilter(fc.Name, f, timeoutCb, fc.Timeout, fm)
+		}
+
+		if pluginMetricsEnabled {
+			f = NewMetricsFilter(fc.Name, f)
+		}
+
+		if tracingEnabled {
+			f = NewTracingFilter(fc.Name, f, fm)
+		}
+
+		if fc.Matcher != nil {
+			f = NewMatchFilter(fc.Name, f, fc.Matcher, fm.callbacks, fm)
+		}
+
 		if logExecution {
 			filters[i] = model.NewFilterWrapper(fc.Name, NewLogExecutionFilter(fc.Name, f, fm.callbacks))
 		} else {
@@ -229,7 +266,10 @@ func FilterManagerFactory(c interface{}, cb capi.FilterCallbackHandler) (streamF
 
 	// The skip check is based on the compiled code. So if the DecodeRequest is defined,
 	// even it is not called, DecodeData will not be skipped. Same as EncodeResponse.
-	fm.canSkipDecodeHeaders = fm.canSkipMethods["DecodeHeaders"] && fm.canSkipMethods["DecodeRequest"] && fm.config.initOnce == nil
+	// The match expression is evaluated with the request headers, so we can't skip DecodeHeaders
+	// if any plugin has it. So does the tracing which extracts the trace context from the headers.
+	fm.canSkipDecodeHeaders = fm.canSkipMethods["DecodeHeaders"] && fm.canSkipMethods["DecodeRequest"] &&
+		fm.config.initOnce == nil && !fm.config.hasMatcher && !tracingEnabled
 	fm.canSkipDecodeData = fm.canSkipMethods["DecodeData"] && fm.canSkipMethods["DecodeRequest"]
 	fm.canSkipDecodeTrailers = fm.canSkipMethods["DecodeTrailers"] && fm.canSkipMethods["DecodeRequest"]
 	fm.canSkipEncodeHeaders = fm.canSkipMethods["EncodeHeaders"]
@@ -260,6 +300,10 @@ func (m *filterManager) recordLocalReplyPluginName(name string, code int) {
 	// Also log it in the application log. In some situation, multiple plugins may send local reply.
 	// Via the application log, we can know all the calls.
 	api.LogInfof("local reply from plugin: %s, code: %d", name, code)
+
+	if pluginMetricsEnabled {
+		recordPluginLocalReply(name, code)
+	}
 }
 
 func (m *filterManager) handleAction(res api.ResultAction, phase api.Phase, filter *model.FilterWrapper) (needReturn bool) {
@@ -291,6 +335,9 @@ func (m *filterManager) handleAction(res api.ResultAction, phase api.Phase, filt
 		m.recordLocalReplyPluginName(filter.Name, v.Code)
 		m.localReply(v, phase < api.PhaseEncodeHeaders)
 		return true
+	case *api.TransformBody:
+		m.addBodyTransformer(v, phase, filter)
+		return false
 	default:
 		api.LogErrorf("unknown result action: %+v returned from %s in phase %s", v, filter.Name, phase)
 		return false
@@ -406,14 +453,32 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 		}
 	}
 	m.hdrLock.Unlock()
+
+	if m.tracingEnabled {
+		m.extractTraceParent(m.reqHdr)
+	}
 	if m.config.consumerFiltersEndAt != 0 {
 		for i := 0; i < m.config.consumerFiltersEndAt; i++ {
 			f := m.filters[i]
-			// We don't support DecodeRequest for now
 			res = f.DecodeHeaders(m.reqHdr, endStream)
 			if m.handleAction(res, api.PhaseDecodeHeaders, f) {
 				return capi.LocalReply
 			}
+
+			if m.decodeRequestNeeded {
+				m.decodeRequestNeeded = false
+				if !endStream {
+					// The consumer is determined by the headers, and the body is verified later,
+					// so we can merge the consumer's filters before buffering the body.
+					m.consumerFiltersWaitingBody = append(m.consumerFiltersWaitingBody, f)
+					continue
+				}
+
+				res = f.DecodeRequest(m.reqHdr, nil, nil)
+				if m.handleAction(res, api.PhaseDecodeRequest, f) {
+					return capi.LocalReply
+				}
+			}
 		}
 
 		// we check consumer at the end of authn filters, so we can have multiple authn filters
@@ -441,11 +506,19 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 			})
 
 			filterWrappers := make([]*model.FilterWrapper, len(c.FilterConfigs))
+			var timeoutCbs []*timeoutCallbackHandler
 			for i, name := range c.FilterNames {
 				fc := c.FilterConfigs[name]
 				factory := fc.Factory
 				config := fc.ParsedConfig
-				f := factory(config, m.callbacks)
+				var cb api.FilterCallbackHandler = m.callbacks
+				var timeoutCb *timeoutCallbackHandler
+				if fc.Timeout > 0 {
+					timeoutCb = newTimeoutCallbackHandler(m.callbacks)
+					cb = timeoutCb
+				}
+				timeoutCbs = append(timeoutCbs, timeoutCb)
+				f := factory(config, cb)
 				filterWrappers[i] = model.NewFilterWrapper(name, f)
 			}
 
@@ -472,6 +545,26 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 				c.CanSyncRunMethod = canSyncRunMethods
 			})
 
+			// wrap the filters after the method check, so the check is done with the original filter
+			for i, fw := range filterWrappers {
+				if timeoutCbs[i] != nil {
+					fc := c.FilterConfigs[fw.Name]
+					fw.Filter = NewTimeoutFilter(fw.Name, fw.Filter, timeoutCbs[i], fc.Timeout, m)
+				}
+			}
+
+			if pluginMetricsEnabled {
+				for _, fw := range filterWrappers {
+					fw.Filter = NewMetricsFilter(fw.Name, fw.Filter)
+				}
+			}
+
+			if m.tracingEnabled {
+				for _, fw := range filterWrappers {
+					fw.Filter = NewTracingFilter(fw.Name, fw.Filter, m)
+				}
+			}
+
 			if needLogExecution() {
 				for _, fw := range filterWrappers {
 					f := fw.Filter
@@ -533,6 +626,17 @@ func (m *filterManager) decodeHeaders(headers capi.RequestHeaderMap, endStream b
 		}
 	}
 
+	if len(m.consumerFiltersWaitingBody) > 0 {
+		// The remaining filters are run after the consumer filters verify the whole request
+		for i, f := range m.filters {
+			if f == m.consumerFiltersWaitingBody[0] {
+				m.decodeIdx = i
+				break
+			}
+		}
+		return capi.StopAndBuffer
+	}
+
 	for i := m.config.consumerFiltersEndAt; i < len(m.filters); i++ {
 		f := m.filters[i]
 		res = f.DecodeHeaders(m.reqHdr, endStream)
@@ -574,6 +678,10 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 			if m.handleAction(res, api.PhaseDecodeData, f) {
 				return false
 			}
+			// the buffered body is complete, so the transformer can flush the data held back
+			if m.transformData(m.decodeTransformers, buf, true, api.PhaseDecodeData, f) {
+				return false
+			}
 		}
 	}
 
@@ -599,6 +707,15 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 	for i < n {
 		for ; i < n; i++ {
 			f := m.filters[i]
+			if i < m.config.consumerFiltersEndAt {
+				// DecodeHeaders of the consumer filters are already run
+				if m.isConsumerFilterWaitingBody(f) {
+					m.decodeRequestNeeded = true
+					break
+				}
+				continue
+			}
+
 			// The endStream in DecodeHeaders indicates whether there is a body.
 			// The body always exists when we hit this path.
 			res = f.DecodeHeaders(headers, false)
@@ -620,6 +737,9 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 				if m.handleAction(res, api.PhaseDecodeData, f) {
 					return false
 				}
+				if m.transformData(m.decodeTransformers, buf, true, api.PhaseDecodeData, f) {
+					return false
+				}
 			}
 		}
 
@@ -648,6 +768,15 @@ func (m *filterManager) DecodeRequest(headers api.RequestHeaderMap, buf capi.Buf
 	return true
 }
 
+func (m *filterManager) isConsumerFilterWaitingBody(f *model.FilterWrapper) bool {
+	for _, w := range m.consumerFiltersWaitingBody {
+		if w == f {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *filterManager) DecodeData(buf capi.BufferInstance, endStream bool) capi.StatusType {
 	if m.canSkipDecodeData {
 		return capi.Continue
@@ -698,6 +827,9 @@ func (m *filterManager) decodeData(buf capi.BufferInstance, endStream bool) capi
 			if m.handleAction(res, api.PhaseDecodeData, f) {
 				return capi.LocalReply
 			}
+			if m.transformData(m.decodeTransformers, buf, endStream, api.PhaseDecodeData, f) {
+				return capi.LocalReply
+			}
 		}
 	} else if endStream {
 		conti := m.DecodeRequest(m.reqHdr, buf, nil)
@@ -740,6 +872,9 @@ func (m *filterManager) decodeTrailers(trailers capi.RequestTrailerMap) capi.Sta
 	var res api.ResultAction
 
 	if m.decodeIdx == -1 {
+		if m.flushTransformers(m.decodeTransformers, true) {
+			return capi.LocalReply
+		}
 		for _, f := range m.filters {
 			res = f.DecodeTrailers(trailers)
 			if m.handleAction(res, api.PhaseDecodeTrailers, f) {
@@ -835,6 +970,10 @@ func (m *filterManager) EncodeResponse(headers api.ResponseHeaderMap, buf capi.B
 			if m.handleAction(res, api.PhaseEncodeData, f) {
 				return false
 			}
+			// the buffered body is complete, so the transformer can flush the data held back
+			if m.transformData(m.encodeTransformers, buf, true, api.PhaseEncodeData, f) {
+				return false
+			}
 		}
 	}
 
@@ -875,6 +1014,9 @@ func (m *filterManager) EncodeResponse(headers api.ResponseHeaderMap, buf capi.B
 				if m.handleAction(res, api.PhaseEncodeData, f) {
 					return false
 				}
+				if m.transformData(m.encodeTransformers, buf, true, api.PhaseEncodeData, f) {
+					return false
+				}
 			}
 		}
 
@@ -940,6 +1082,9 @@ func (m *filterManager) encodeData(buf capi.BufferInstance, endStream bool) capi
 			if m.handleAction(res, api.PhaseEncodeData, f) {
 				return capi.LocalReply
 			}
+			if m.transformData(m.encodeTransformers, buf, endStream, api.PhaseEncodeData, f) {
+				return capi.LocalReply
+			}
 		}
 	} else {
 		// FIXME: we should implement like the decode part here, but it will cause server closed the stream without sending trailers.
@@ -981,6 +1126,9 @@ func (m *filterManager) encodeTrailers(trailers capi.ResponseTrailerMap) capi.St
 	var res api.ResultAction
 
 	if m.encodeIdx == -1 {
+		if m.flushTransformers(m.encodeTransformers, false) {
+			return capi.LocalReply
+		}
 		for _, f := range m.filters {
 			res = f.EncodeTrailers(trailers)
 			if m.handleAction(res, api.PhaseEncodeTrailers, f) {
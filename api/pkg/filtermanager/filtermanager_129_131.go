@@ -27,6 +27,7 @@ const (
 )
 
 func (m *filterManager) OnLog(_ capi.RequestHeaderMap, _ capi.RequestTrailerMap, _ capi.ResponseHeaderMap, _ capi.ResponseTrailerMap) {
+	m.endRequestSpan()
 	if m.canSkipOnLog {
 		return
 	}
@@ -25,6 +25,7 @@ const (
 )
 
 func (m *filterManager) OnLog(reqHdr capi.RequestHeaderMap, reqTrailer capi.RequestTrailerMap, rspHdr capi.ResponseHeaderMap, rspTrailer capi.ResponseTrailerMap) {
+	m.endRequestSpan()
 	if m.canSkipOnLog {
 		return
 	}
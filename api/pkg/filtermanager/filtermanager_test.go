@@ -15,12 +15,17 @@
 package filtermanager
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
@@ -29,6 +34,7 @@ import (
 	internalConsumer "mosn.io/htnn/api/internal/consumer"
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/filtermanager/model"
+	"mosn.io/htnn/api/pkg/tracing"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 )
 
@@ -834,6 +840,333 @@ func TestSyncRunWhenProcessingBufferedDataWithFiltersFromConsumer(t *testing.T)
 	cb.WaitContinued()
 }
 
+type verifyBodyFilter struct {
+	api.PassThroughFilter
+
+	calls *[]string
+}
+
+func (f *verifyBodyFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	*f.calls = append(*f.calls, "verify DecodeHeaders")
+	return api.WaitAllData
+}
+
+func (f *verifyBodyFilter) DecodeRequest(_ api.RequestHeaderMap, buf api.BufferInstance, _ api.RequestTrailerMap) api.ResultAction {
+	body := ""
+	if buf != nil {
+		body = buf.String()
+	}
+	*f.calls = append(*f.calls, "verify DecodeRequest "+body)
+	if body == "bad" {
+		return &api.LocalResponse{Code: 401}
+	}
+	return api.Continue
+}
+
+type recordHeadersFilter struct {
+	api.PassThroughFilter
+
+	calls *[]string
+}
+
+func (f *recordHeadersFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	*f.calls = append(*f.calls, "record DecodeHeaders")
+	return api.Continue
+}
+
+func TestConsumerFilterWaitAllData(t *testing.T) {
+	consumers := map[string]*internalConsumer.Consumer{
+		"0": {},
+	}
+	tests := []struct {
+		name      string
+		body      string
+		endStream bool
+		calls     []string
+		code      int
+	}{
+		{
+			name:  "pass",
+			body:  "ok",
+			calls: []string{"verify DecodeHeaders", "verify DecodeRequest ok", "record DecodeHeaders"},
+		},
+		{
+			name:  "reject",
+			body:  "bad",
+			calls: []string{"verify DecodeHeaders", "verify DecodeRequest bad"},
+			code:  401,
+		},
+		{
+			name:      "no body",
+			endStream: true,
+			calls:     []string{"verify DecodeHeaders", "verify DecodeRequest ", "record DecodeHeaders"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := []string{}
+			config := initFilterManagerConfig("ns")
+			config.consumerFiltersEndAt = 2
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name:    "1_set_consumer",
+					Factory: setConsumerFactory,
+					ParsedConfig: setConsumerConf{
+						Consumers: consumers,
+					},
+				},
+				{
+					Name: "2_verify_body",
+					Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+						return &verifyBodyFilter{calls: &calls}
+					},
+				},
+				{
+					Name: "3_record",
+					Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+						return &recordHeadersFilter{calls: &calls}
+					},
+				},
+			}
+
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			h := http.Header{}
+			h.Add("consumer", "0")
+			hdr := envoy.NewRequestHeaderMap(h)
+			m.DecodeHeaders(hdr, tt.endStream)
+			res := cb.WaitContinued()
+			if !tt.endStream {
+				assert.Equal(t, capi.StopAndBuffer, res)
+				assert.Equal(t, 1, m.decodeIdx)
+
+				buf := envoy.NewBufferInstance([]byte(tt.body))
+				m.DecodeData(buf, true)
+				cb.WaitContinued()
+			}
+
+			assert.Equal(t, tt.calls, calls)
+			assert.Equal(t, tt.code, cb.LocalResponse().Code)
+		})
+	}
+}
+
+type recordDecodeFilter struct {
+	api.PassThroughFilter
+
+	name  string
+	calls *[]string
+}
+
+func (f *recordDecodeFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	*f.calls = append(*f.calls, f.name+" DecodeHeaders")
+	return api.Continue
+}
+
+func (f *recordDecodeFilter) DecodeData(buf api.BufferInstance, endStream bool) api.ResultAction {
+	*f.calls = append(*f.calls, fmt.Sprintf("%s DecodeData %s %v", f.name, buf.String(), endStream))
+	return api.Continue
+}
+
+func (f *recordDecodeFilter) DecodeTrailers(_ api.RequestTrailerMap) api.ResultAction {
+	*f.calls = append(*f.calls, f.name+" DecodeTrailers")
+	return api.Continue
+}
+
+// TestConsumerFilterWaitAllDataWithFiltersFromConsumer covers the semantics of an Authn plugin
+// which returns WaitAllData when the consumer has its own plugins:
+// 1. The consumer is determined by the headers, so the plugins from the consumer are merged
+// before the body is received.
+// 2. The DecodeHeaders of the other Authn plugins are run only once, before the body is received.
+// 3. The plugins after the waiting plugin, including the ones from the consumer, run after
+// its DecodeRequest succeeds, and receive the whole body.
+func TestConsumerFilterWaitAllDataWithFiltersFromConsumer(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		trailers  bool
+		endStream bool
+		calls     []string
+		code      int
+	}{
+		{
+			name: "pass",
+			body: "ok",
+			calls: []string{
+				"verify DecodeHeaders", "authn DecodeHeaders",
+				"verify DecodeRequest ok",
+				"consumer DecodeHeaders",
+				"authn DecodeData ok true", "consumer DecodeData ok true",
+			},
+		},
+		{
+			name:     "pass with trailers",
+			body:     "ok",
+			trailers: true,
+			calls: []string{
+				"verify DecodeHeaders", "authn DecodeHeaders",
+				"verify DecodeRequest ok",
+				"consumer DecodeHeaders",
+				"authn DecodeData ok false", "consumer DecodeData ok false",
+				"authn DecodeTrailers", "consumer DecodeTrailers",
+			},
+		},
+		{
+			name: "reject",
+			body: "bad",
+			calls: []string{
+				"verify DecodeHeaders", "authn DecodeHeaders",
+				"verify DecodeRequest bad",
+			},
+			code: 401,
+		},
+		{
+			name:      "no body",
+			endStream: true,
+			calls: []string{
+				"verify DecodeHeaders", "verify DecodeRequest ", "authn DecodeHeaders",
+				"consumer DecodeHeaders",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := []string{}
+			consumers := map[string]*internalConsumer.Consumer{
+				"0": {
+					FilterConfigs: map[string]*model.ParsedFilterConfig{
+						"4_record": {
+							Name: "4_record",
+							Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+								return &recordDecodeFilter{name: "consumer", calls: &calls}
+							},
+						},
+					},
+				},
+			}
+			config := initFilterManagerConfig("ns")
+			config.consumerFiltersEndAt = 3
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name:    "1_set_consumer",
+					Factory: setConsumerFactory,
+					ParsedConfig: setConsumerConf{
+						Consumers: consumers,
+					},
+				},
+				{
+					Name: "2_verify_body",
+					Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+						return &verifyBodyFilter{calls: &calls}
+					},
+				},
+				{
+					Name: "3_authn_record",
+					Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+						return &recordDecodeFilter{name: "authn", calls: &calls}
+					},
+				},
+			}
+
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			// DecodeRequest can't be skipped
+			assert.False(t, m.canSkipDecodeData)
+			assert.False(t, m.canSkipDecodeTrailers)
+
+			h := http.Header{}
+			h.Add("consumer", "0")
+			hdr := envoy.NewRequestHeaderMap(h)
+			m.DecodeHeaders(hdr, tt.endStream)
+			res := cb.WaitContinued()
+			// the plugins from the consumer are merged before the body is received
+			assert.Equal(t, 4, len(m.filters))
+			if !tt.endStream {
+				assert.Equal(t, capi.StopAndBuffer, res)
+				assert.Equal(t, 1, m.decodeIdx)
+
+				buf := envoy.NewBufferInstance([]byte(tt.body))
+				m.DecodeData(buf, !tt.trailers)
+				res = cb.WaitContinued()
+				if tt.trailers {
+					assert.Equal(t, capi.StopAndBuffer, res)
+					// nothing is run until the whole request is received
+					assert.Equal(t, []string{"verify DecodeHeaders", "authn DecodeHeaders"}, calls)
+
+					m.DecodeTrailers(envoy.NewRequestTrailerMap(http.Header{}))
+					cb.WaitContinued()
+				}
+			} else {
+				assert.Equal(t, capi.Continue, res)
+			}
+
+			assert.Equal(t, tt.calls, calls)
+			assert.Equal(t, tt.code, cb.LocalResponse().Code)
+		})
+	}
+}
+
+func TestConsumerFilterWaitAllDataMultipleWaiting(t *testing.T) {
+	calls := []string{}
+	consumers := map[string]*internalConsumer.Consumer{
+		"0": {},
+	}
+	config := initFilterManagerConfig("ns")
+	config.consumerFiltersEndAt = 3
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name:    "1_set_consumer",
+			Factory: setConsumerFactory,
+			ParsedConfig: setConsumerConf{
+				Consumers: consumers,
+			},
+		},
+		{
+			Name: "2_verify_body",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &verifyBodyFilter{calls: &calls}
+			},
+		},
+		{
+			Name: "3_verify_body",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &verifyBodyFilter{calls: &calls}
+			},
+		},
+		{
+			Name: "4_record",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &recordDecodeFilter{name: "record", calls: &calls}
+			},
+		},
+	}
+
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	h := http.Header{}
+	h.Add("consumer", "0")
+	hdr := envoy.NewRequestHeaderMap(h)
+	m.DecodeHeaders(hdr, false)
+	assert.Equal(t, capi.StopAndBuffer, cb.WaitContinued())
+	assert.Equal(t, 1, m.decodeIdx)
+
+	m.DecodeData(envoy.NewBufferInstance([]byte("ok")), true)
+	cb.WaitContinued()
+	// each waiting plugin verifies the whole request in order
+	assert.Equal(t, []string{
+		"verify DecodeHeaders", "verify DecodeHeaders",
+		"verify DecodeRequest ok", "verify DecodeRequest ok",
+		"record DecodeHeaders", "record DecodeData ok true",
+	}, calls)
+	assert.Equal(t, 0, cb.LocalResponse().Code)
+
+	// the state is reset for the next request
+	m.Reset()
+	assert.Nil(t, m.consumerFiltersWaitingBody)
+}
+
 func waitDataFactory(_ interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &waitDataFilter{
 		cb: callbacks,
@@ -875,3 +1208,709 @@ func TestWaitData(t *testing.T) {
 	res = cb.WaitContinued()
 	assert.Equal(t, capi.StopAndBufferWatermark, res)
 }
+
+func upperLines(data []byte) ([]byte, []byte) {
+	idx := bytes.LastIndexByte(data, '\n')
+	return bytes.ToUpper(data[:idx+1]), data[idx+1:]
+}
+
+type transformBodyFilter struct {
+	api.PassThroughFilter
+
+	maxLookahead int
+}
+
+func (f *transformBodyFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	return &api.TransformBody{
+		Transformer: &api.BodyTransformerFuncs{
+			TransformFunc: upperLines,
+			FlushFunc:     bytes.ToUpper,
+		},
+		MaxLookahead: f.maxLookahead,
+	}
+}
+
+func (f *transformBodyFilter) EncodeHeaders(_ api.ResponseHeaderMap, _ bool) api.ResultAction {
+	return &api.TransformBody{
+		Transformer: &api.BodyTransformerFuncs{
+			TransformFunc: upperLines,
+		},
+		MaxLookahead: f.maxLookahead,
+	}
+}
+
+type recordDataFilter struct {
+	api.PassThroughFilter
+
+	decoded []string
+	encoded []string
+}
+
+func (f *recordDataFilter) DecodeData(data api.BufferInstance, _ bool) api.ResultAction {
+	f.decoded = append(f.decoded, data.String())
+	return api.Continue
+}
+
+func (f *recordDataFilter) EncodeData(data api.BufferInstance, _ bool) api.ResultAction {
+	f.encoded = append(f.encoded, data.String())
+	return api.Continue
+}
+
+func TestTransformBody(t *testing.T) {
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	recorder := &recordDataFilter{}
+	// Decode path runs transformer -> recorder, Encode path runs recorder -> transformer
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "transformer",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return &transformBodyFilter{}
+			},
+		},
+		{
+			Name: "recorder",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return recorder
+			},
+		},
+	}
+
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	h := http.Header{}
+	h.Set("content-length", "10")
+	hdr := envoy.NewRequestHeaderMap(h)
+	m.DecodeHeaders(hdr, false)
+	assert.Equal(t, capi.Continue, cb.WaitContinued())
+	_, ok := hdr.Get("content-length")
+	assert.False(t, ok)
+
+	for _, chunk := range []struct {
+		data      string
+		endStream bool
+		expect    string
+	}{
+		{"ab\ncd", false, "AB\n"},
+		{"ef\n", false, "CDEF\n"},
+		{"", false, ""},
+		{"gh", true, "GH"},
+	} {
+		buf := envoy.NewBufferInstance([]byte(chunk.data))
+		m.DecodeData(buf, chunk.endStream)
+		assert.Equal(t, capi.Continue, cb.WaitContinued())
+		assert.Equal(t, chunk.expect, buf.String())
+	}
+	assert.Equal(t, []string{"AB\n", "CDEF\n", "", "GH"}, recorder.decoded)
+
+	respHdr := envoy.NewResponseHeaderMap(http.Header{})
+	m.EncodeHeaders(respHdr, false)
+	assert.Equal(t, capi.Continue, cb.WaitContinued())
+	buf := envoy.NewBufferInstance([]byte("data: a\n\ndata"))
+	m.EncodeData(buf, false)
+	cb.WaitContinued()
+	assert.Equal(t, "DATA: A\n\n", buf.String())
+	buf = envoy.NewBufferInstance([]byte(": b\n"))
+	m.EncodeData(buf, true)
+	cb.WaitContinued()
+	assert.Equal(t, "DATA: B\n", buf.String())
+	assert.Equal(t, []string{"data: a\n\ndata", ": b\n"}, recorder.encoded)
+}
+
+func TestTransformBodyWithTrailers(t *testing.T) {
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	before := &recordDataFilter{}
+	after := &recordDataFilter{}
+	// Decode path runs transformer -> after, Encode path runs transformer -> before
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "before",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return before
+			},
+		},
+		{
+			Name: "transformer",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return &transformBodyFilter{}
+			},
+		},
+		{
+			Name: "after",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return after
+			},
+		},
+	}
+
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	var added []string
+	addData := func(data []byte, _ bool) {
+		added = append(added, string(data))
+	}
+	decoderCallbacks := m.callbacks.DecoderFilterCallbacks()
+	patches := gomonkey.ApplyMethodFunc(decoderCallbacks, "AddData", addData)
+	if encoderCallbacks := m.callbacks.EncoderFilterCallbacks(); reflect.TypeOf(encoderCallbacks) != reflect.TypeOf(decoderCallbacks) {
+		patches.ApplyMethodFunc(encoderCallbacks, "AddData", addData)
+	}
+	defer patches.Reset()
+
+	m.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), false)
+	cb.WaitContinued()
+	buf := envoy.NewBufferInstance([]byte("ab\ncd"))
+	m.DecodeData(buf, false)
+	cb.WaitContinued()
+	assert.Equal(t, "AB\n", buf.String())
+	m.DecodeTrailers(envoy.NewRequestTrailerMap(http.Header{}))
+	cb.WaitContinued()
+	// the flushed data is seen by the later plugins before the trailers
+	assert.Equal(t, []string{"AB\n", "CD"}, after.decoded)
+	assert.Equal(t, []string{"CD"}, added)
+
+	m.EncodeHeaders(envoy.NewResponseHeaderMap(http.Header{}), false)
+	cb.WaitContinued()
+	buf = envoy.NewBufferInstance([]byte("data: a\n\ndata"))
+	m.EncodeData(buf, false)
+	cb.WaitContinued()
+	m.EncodeTrailers(envoy.NewResponseTrailerMap(http.Header{}))
+	cb.WaitContinued()
+	assert.Equal(t, []string{"DATA: A\n\n", "data"}, before.encoded)
+	assert.Equal(t, []string{"CD", "data"}, added)
+}
+
+type bufferBodyFilter struct {
+	api.PassThroughFilter
+
+	body string
+}
+
+func (f *bufferBodyFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	return api.WaitAllData
+}
+
+func (f *bufferBodyFilter) DecodeRequest(_ api.RequestHeaderMap, data api.BufferInstance, _ api.RequestTrailerMap) api.ResultAction {
+	f.body = data.String()
+	return api.Continue
+}
+
+func TestTransformBufferedBodyWithTrailers(t *testing.T) {
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	buffer := &bufferBodyFilter{}
+	after := &recordDataFilter{}
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "transformer",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return &transformBodyFilter{}
+			},
+		},
+		{
+			Name: "buffer",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return buffer
+			},
+		},
+		{
+			Name: "after",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return after
+			},
+		},
+	}
+
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	m.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), false)
+	cb.WaitContinued()
+	buf := envoy.NewBufferInstance([]byte("ab\ncd"))
+	m.DecodeData(buf, false)
+	cb.WaitContinued()
+	m.DecodeTrailers(envoy.NewRequestTrailerMap(http.Header{}))
+	cb.WaitContinued()
+	assert.Equal(t, "AB\nCD", buffer.body)
+	assert.Equal(t, []string{"AB\nCD"}, after.decoded)
+	assert.Equal(t, "AB\nCD", buf.String())
+}
+
+func TestTransformBodyLookaheadExceeded(t *testing.T) {
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "transformer",
+			Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+				return &transformBodyFilter{maxLookahead: 4}
+			},
+		},
+	}
+
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	m.DecodeHeaders(hdr, false)
+	cb.WaitContinued()
+	buf := envoy.NewBufferInstance([]byte("a\nbcde"))
+	m.DecodeData(buf, false)
+	cb.WaitContinued()
+	assert.Equal(t, 0, cb.LocalResponse().Code)
+	buf = envoy.NewBufferInstance([]byte("f"))
+	m.DecodeData(buf, false)
+	cb.WaitContinued()
+	assert.Equal(t, 500, cb.LocalResponse().Code)
+}
+
+type slowFilter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	deadline  chan bool
+}
+
+func (f *slowFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	ctx := f.callbacks.Context()
+	_, ok := ctx.Deadline()
+	f.deadline <- ok
+	<-ctx.Done()
+	return api.Continue
+}
+
+func TestPluginTimeout(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *model.PluginTimeoutConfig
+		code      int
+		continued bool
+	}{
+		{
+			name: "default",
+			code: 504,
+		},
+		{
+			name: "custom response",
+			cfg: &model.PluginTimeoutConfig{
+				StatusCode: 503,
+			},
+			code: 503,
+		},
+		{
+			name: "fail open",
+			cfg: &model.PluginTimeoutConfig{
+				FailOpen: true,
+			},
+			continued: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			config := initFilterManagerConfig("ns")
+			config.pluginTimeout = tt.cfg
+			deadline := make(chan bool, 1)
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name: "slow",
+					Factory: func(_ interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+						return &slowFilter{callbacks: callbacks, deadline: deadline}
+					},
+					Timeout: 10 * time.Millisecond,
+				},
+			}
+
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			hdr := envoy.NewRequestHeaderMap(http.Header{})
+			m.DecodeHeaders(hdr, false)
+			res := cb.WaitContinued()
+			assert.True(t, <-deadline)
+			if tt.continued {
+				assert.Equal(t, capi.Continue, res)
+			} else {
+				assert.Equal(t, tt.code, cb.LocalResponse().Code)
+			}
+			assert.Equal(t, "slow", cb.StreamInfo().DynamicMetadata().Get("htnn")["timeout_plugin_name"])
+		})
+	}
+}
+
+type lateWriteFilter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	release   chan struct{}
+	written   chan struct{}
+	encoded   chan struct{}
+}
+
+func (f *lateWriteFilter) DecodeHeaders(headers api.RequestHeaderMap, _ bool) api.ResultAction {
+	<-f.callbacks.Context().Done()
+	<-f.release
+	headers.Set("x-late", "true")
+	close(f.written)
+	return &api.LocalResponse{Code: 403}
+}
+
+func (f *lateWriteFilter) EncodeHeaders(headers api.ResponseHeaderMap, _ bool) api.ResultAction {
+	close(f.encoded)
+	return api.Continue
+}
+
+type readHeaderFilter struct {
+	api.PassThroughFilter
+
+	late chan string
+}
+
+func (f *readHeaderFilter) DecodeHeaders(headers api.RequestHeaderMap, _ bool) api.ResultAction {
+	v, _ := headers.Get("x-late")
+	f.late <- v
+	return api.Continue
+}
+
+func TestPluginTimeoutDropLateWrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		failOpen bool
+	}{
+		{
+			name:     "fail open",
+			failOpen: true,
+		},
+		{
+			name: "fail closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			config := initFilterManagerConfig("ns")
+			config.pluginTimeout = &model.PluginTimeoutConfig{FailOpen: tt.failOpen}
+			late := make(chan string, 1)
+			f := &lateWriteFilter{
+				release: make(chan struct{}),
+				written: make(chan struct{}),
+				encoded: make(chan struct{}),
+			}
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name: "slow",
+					Factory: func(_ interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+						f.callbacks = callbacks
+						return f
+					},
+					Timeout: 10 * time.Millisecond,
+				},
+				{
+					Name: "next",
+					Factory: func(interface{}, api.FilterCallbackHandler) api.Filter {
+						return &readHeaderFilter{late: late}
+					},
+				},
+			}
+
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			hdr := envoy.NewRequestHeaderMap(http.Header{})
+			// the processing moves on right after the deadline, while the plugin is still running
+			m.DecodeHeaders(hdr, false)
+			res := cb.WaitContinued()
+			if tt.failOpen {
+				assert.Equal(t, capi.Continue, res)
+				assert.Equal(t, "", <-late)
+			} else {
+				assert.Equal(t, 504, cb.LocalResponse().Code)
+			}
+
+			// the later phases are skipped until the plugin returns
+			respHdr := envoy.NewResponseHeaderMap(http.Header{})
+			m.EncodeHeaders(respHdr, true)
+			cb.WaitContinued()
+			select {
+			case <-f.encoded:
+				t.Fatal("the later phase of the timed-out plugin should be skipped")
+			default:
+			}
+
+			close(f.release)
+			<-f.written
+			_, ok := hdr.Get("x-late")
+			assert.False(t, ok)
+		})
+	}
+}
+
+type pathPrefixMatcher struct {
+	prefix string
+}
+
+func (m *pathPrefixMatcher) Match(_ api.FilterCallbackHandler, headers api.RequestHeaderMap) (bool, error) {
+	if m.prefix == "" {
+		return false, errors.New("ouch")
+	}
+	return strings.HasPrefix(headers.Path(), m.prefix), nil
+}
+
+func TestPluginMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		prefix  string
+		matched bool
+	}{
+		{
+			name:    "matched",
+			path:    "/admin/users",
+			prefix:  "/admin",
+			matched: true,
+		},
+		{
+			name:   "unmatched",
+			path:   "/users",
+			prefix: "/admin",
+		},
+		{
+			name:    "run the plugin if the evaluation failed",
+			path:    "/users",
+			matched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewCAPIFilterCallbackHandler()
+			config := initFilterManagerConfig("ns")
+			config.hasMatcher = true
+			matcher := &pathPrefixMatcher{prefix: tt.prefix}
+			config.parsed = []*model.ParsedFilterConfig{
+				{
+					Name:    "add_req",
+					Factory: addReqFactory,
+					ParsedConfig: addReqConf{
+						hdrName: "x-htnn-req",
+					},
+					Matcher: matcher,
+				},
+				{
+					Name:    "add_resp",
+					Factory: addRespFactory,
+					ParsedConfig: addRespConf{
+						hdrName: "x-htnn-resp",
+					},
+					Matcher: matcher,
+				},
+			}
+
+			m := unwrapFilterManager(FilterManagerFactory(config, cb))
+			assert.False(t, m.canSkipDecodeHeaders)
+			hdr := envoy.NewRequestHeaderMap(http.Header{})
+			hdr.SetPath(tt.path)
+			m.DecodeHeaders(hdr, true)
+			cb.WaitContinued()
+			respHdr := envoy.NewResponseHeaderMap(http.Header{})
+			m.EncodeHeaders(respHdr, true)
+			cb.WaitContinued()
+
+			_, ok := hdr.Get("x-htnn-req")
+			assert.Equal(t, tt.matched, ok)
+			_, ok = respHdr.Get("x-htnn-resp")
+			assert.Equal(t, tt.matched, ok)
+		})
+	}
+}
+
+type denyFilter struct {
+	api.PassThroughFilter
+}
+
+type sleepFilter struct {
+	api.PassThroughFilter
+}
+
+func (f *sleepFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	time.Sleep(time.Millisecond)
+	return api.Continue
+}
+
+func (f *denyFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	return &api.LocalResponse{Code: 403}
+}
+
+func TestPluginMetrics(t *testing.T) {
+	pluginMetricsEnabled = true
+	defer func() {
+		pluginMetricsEnabled = false
+	}()
+
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "metrics_sleep",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &sleepFilter{}
+			},
+		},
+		{
+			Name:    "metrics_add_req",
+			Factory: addReqFactory,
+			ParsedConfig: addReqConf{
+				hdrName: "x-htnn-req",
+			},
+		},
+		{
+			Name: "metrics_deny",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &denyFilter{}
+			},
+		},
+		{
+			Name:    "metrics_on_log",
+			Factory: onLogFactory,
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		m := unwrapFilterManager(FilterManagerFactory(config, cb))
+		hdr := envoy.NewRequestHeaderMap(http.Header{})
+		m.DecodeHeaders(hdr, true)
+		cb.WaitContinued()
+		m.OnLog(hdr, nil, nil, nil)
+	}
+
+	for _, name := range []string{"metrics_add_req", "metrics_deny"} {
+		tags := []api.MetricTag{
+			{Key: "plugin", Value: name},
+			{Key: "phase", Value: "DecodeHeaders"},
+		}
+		assert.Equal(t, uint64(2), api.DefineCounter("htnn.plugin.invocations").WithTags(tags...).Get())
+	}
+	// the phases not implemented by the plugin are not counted
+	for _, name := range []string{"metrics_add_req", "metrics_deny"} {
+		tags := []api.MetricTag{
+			{Key: "plugin", Value: name},
+			{Key: "phase", Value: "OnLog"},
+		}
+		assert.Equal(t, uint64(0), api.DefineCounter("htnn.plugin.invocations").WithTags(tags...).Get())
+	}
+	assert.Equal(t, uint64(2), api.DefineCounter("htnn.plugin.invocations").WithTags(
+		api.MetricTag{Key: "plugin", Value: "metrics_on_log"},
+		api.MetricTag{Key: "phase", Value: "OnLog"},
+	).Get())
+	assert.GreaterOrEqual(t, api.DefineCounter("htnn.plugin.latency_us").WithTags(
+		api.MetricTag{Key: "plugin", Value: "metrics_sleep"},
+		api.MetricTag{Key: "phase", Value: "DecodeHeaders"},
+	).Get(), uint64(2000))
+
+	assert.Equal(t, uint64(2), api.DefineCounter("htnn.plugin.local_replies").WithTags(
+		api.MetricTag{Key: "plugin", Value: "metrics_deny"},
+		api.MetricTag{Key: "code", Value: "403"},
+	).Get())
+}
+
+type spanExporter struct {
+	lock  sync.Mutex
+	spans []*tracing.SpanData
+}
+
+func (e *spanExporter) ExportSpans(_ context.Context, spans []*tracing.SpanData) error {
+	e.lock.Lock()
+	e.spans = append(e.spans, spans...)
+	e.lock.Unlock()
+	return nil
+}
+
+type traceFilter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	spans     *[]*tracing.Span
+}
+
+func (f *traceFilter) DecodeHeaders(_ api.RequestHeaderMap, _ bool) api.ResultAction {
+	*f.spans = append(*f.spans, tracing.SpanFromContext(f.callbacks.Context()))
+	return api.Continue
+}
+
+func TestPluginTracing(t *testing.T) {
+	exporter := &spanExporter{}
+	tracing.SetExporter(exporter)
+	defer tracing.SetExporter(nil)
+
+	cb := envoy.NewCAPIFilterCallbackHandler()
+	config := initFilterManagerConfig("ns")
+	var spans []*tracing.Span
+	config.parsed = []*model.ParsedFilterConfig{
+		{
+			Name: "trace",
+			Factory: func(_ interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+				return &traceFilter{callbacks: callbacks, spans: &spans}
+			},
+		},
+		{
+			Name: "trace_deny",
+			Factory: func(_ interface{}, _ api.FilterCallbackHandler) api.Filter {
+				return &denyFilter{}
+			},
+		},
+	}
+
+	m := unwrapFilterManager(FilterManagerFactory(config, cb))
+	hdr := envoy.NewRequestHeaderMap(http.Header{
+		"Traceparent": []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
+	})
+	m.DecodeHeaders(hdr, true)
+	cb.WaitContinued()
+
+	// the request is not traced
+	m = unwrapFilterManager(FilterManagerFactory(config, cb))
+	hdr = envoy.NewRequestHeaderMap(http.Header{})
+	m.DecodeHeaders(hdr, true)
+	cb.WaitContinued()
+
+	// start a new trace for the request without trace context
+	tracing.SetRootSampleRatio(1)
+	defer tracing.SetRootSampleRatio(0)
+	m = unwrapFilterManager(FilterManagerFactory(config, cb))
+	hdr = envoy.NewRequestHeaderMap(http.Header{})
+	m.DecodeHeaders(hdr, true)
+	cb.WaitContinued()
+	m.OnLog(hdr, nil, nil, nil)
+
+	// the sampling decision of the trace context is respected
+	m = unwrapFilterManager(FilterManagerFactory(config, cb))
+	hdr = envoy.NewRequestHeaderMap(http.Header{
+		"Traceparent": []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00"},
+	})
+	m.DecodeHeaders(hdr, true)
+	cb.WaitContinued()
+
+	// flush the spans
+	tracing.SetExporter(nil)
+
+	assert.Len(t, spans, 4)
+	assert.NotNil(t, spans[0])
+	assert.Nil(t, spans[1])
+	assert.NotNil(t, spans[2])
+	assert.Nil(t, spans[3])
+	assert.Len(t, exporter.spans, 5)
+	// the plugin spans share the same root span, which is ended in the OnLog phase
+	root := exporter.spans[4]
+	assert.Equal(t, "htnn request", root.Name)
+	assert.NotEqual(t, "0af7651916cd43dd8448eb211c80319c", root.TraceID.String())
+	assert.False(t, root.ParentSpanID.IsValid())
+	assert.Equal(t, "trace DecodeHeaders", exporter.spans[2].Name)
+	assert.Equal(t, "trace_deny DecodeHeaders", exporter.spans[3].Name)
+	for _, span := range exporter.spans[2:4] {
+		assert.Equal(t, root.TraceID, span.TraceID)
+		assert.Equal(t, root.SpanID, span.ParentSpanID)
+	}
+	exporter.spans = exporter.spans[:2]
+	for _, span := range exporter.spans {
+		assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", span.TraceID.String())
+		assert.Equal(t, "b7ad6b7169203331", span.ParentSpanID.String())
+		assert.Equal(t, "DecodeHeaders", span.Attributes["htnn.phase"])
+	}
+	assert.Equal(t, "trace DecodeHeaders", exporter.spans[0].Name)
+	assert.Equal(t, spans[0].SpanContext().SpanID, exporter.spans[0].SpanID)
+	assert.Equal(t, "trace_deny DecodeHeaders", exporter.spans[1].Name)
+	assert.Equal(t, "403", exporter.spans[1].Attributes["http.status_code"])
+	assert.Empty(t, exporter.spans[1].Error)
+}
@@ -0,0 +1,173 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"errors"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+)
+
+// PluginMatcherCompiler compiles the `match` expression of the plugin.
+type PluginMatcherCompiler func(expr string) (model.PluginMatcher, error)
+
+var pluginMatcherCompiler PluginMatcherCompiler
+
+// RegisterPluginMatcherCompiler registers the compiler of the `match` expression.
+// The CEL compiler is registered by mosn.io/htnn/types/pkg/expr, so that the api module
+// doesn't need to depend on CEL.
+func RegisterPluginMatcherCompiler(compiler PluginMatcherCompiler) {
+	pluginMatcherCompiler = compiler
+}
+
+func compilePluginMatcher(expr string) (model.PluginMatcher, error) {
+	if pluginMatcherCompiler == nil {
+		return nil, errors.New("match expression is not supported as no compiler is registered")
+	}
+	return pluginMatcherCompiler(expr)
+}
+
+type matchState int
+
+const (
+	matchStateUnknown matchState = iota
+	matchStateMatched
+	matchStateUnmatched
+)
+
+type matchFilter struct {
+	// Don't inherit the PassThroughFilter
+	name      string
+	internal  api.Filter
+	matcher   model.PluginMatcher
+	callbacks api.FilterCallbackHandler
+	fm        *filterManager
+
+	state matchState
+}
+
+func NewMatchFilter(name string, internal api.Filter, matcher model.PluginMatcher,
+	callbacks api.FilterCallbackHandler, fm *filterManager) api.Filter {
+
+	return &matchFilter{
+		name:      name,
+		internal:  internal,
+		matcher:   matcher,
+		callbacks: callbacks,
+		fm:        fm,
+	}
+}
+
+// skip evaluates the match expression when the plugin is reached for the first time,
+// and returns true if the plugin should not be run in this request.
+// When the expression fails to evaluate, the plugin is run, so that a plugin like authentication
+// won't be bypassed by accident.
+func (f *matchFilter) skip(headers api.RequestHeaderMap) bool {
+	if f.state != matchStateUnknown {
+		return f.state == matchStateUnmatched
+	}
+
+	if headers == nil {
+		f.fm.hdrLock.Lock()
+		if f.fm.reqHdr != nil {
+			headers = f.fm.reqHdr
+		}
+		f.fm.hdrLock.Unlock()
+	}
+
+	if headers == nil {
+		// The request is terminated before the plugin processes it
+		f.state = matchStateUnmatched
+	} else {
+		matched, err := f.matcher.Match(f.callbacks, headers)
+		if err != nil {
+			api.LogErrorf("failed to evaluate the match expression of plugin %s: %v", f.name, err)
+			matched = true
+		}
+		if matched {
+			f.state = matchStateMatched
+		} else {
+			api.LogDebugf("plugin %s is skipped as the match expression is false", f.name)
+			f.state = matchStateUnmatched
+		}
+	}
+	return f.state == matchStateUnmatched
+}
+
+func (f *matchFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	if f.skip(headers) {
+		return api.Continue
+	}
+	return f.internal.DecodeHeaders(headers, endStream)
+}
+
+func (f *matchFilter) DecodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.DecodeData(data, endStream)
+}
+
+func (f *matchFilter) DecodeTrailers(trailers api.RequestTrailerMap) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.DecodeTrailers(trailers)
+}
+
+func (f *matchFilter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.EncodeHeaders(headers, endStream)
+}
+
+func (f *matchFilter) EncodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.EncodeData(data, endStream)
+}
+
+func (f *matchFilter) EncodeTrailers(trailers api.ResponseTrailerMap) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.EncodeTrailers(trailers)
+}
+
+func (f *matchFilter) OnLog(reqHeaders api.RequestHeaderMap, reqTrailers api.RequestTrailerMap,
+	respHeaders api.ResponseHeaderMap, respTrailers api.ResponseTrailerMap) {
+
+	if f.skip(reqHeaders) {
+		return
+	}
+	f.internal.OnLog(reqHeaders, reqTrailers, respHeaders, respTrailers)
+}
+
+func (f *matchFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	if f.skip(headers) {
+		return api.Continue
+	}
+	return f.internal.DecodeRequest(headers, data, trailers)
+}
+
+func (f *matchFilter) EncodeResponse(headers api.ResponseHeaderMap, data api.BufferInstance, trailers api.ResponseTrailerMap) api.ResultAction {
+	if f.skip(nil) {
+		return api.Continue
+	}
+	return f.internal.EncodeResponse(headers, data, trailers)
+}
@@ -0,0 +1,154 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"mosn.io/htnn/api/internal/reflectx"
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+var (
+	// The built-in metrics add a little overhead to each call, so they are disabled by default.
+	pluginMetricsEnabled = os.Getenv("HTNN_ENABLE_PLUGIN_METRICS") == "true"
+
+	// Histograms are not supported, so we record the total latency in a counter.
+	// The average latency can be computed with the invocations.
+	pluginInvocations  = api.DefineCounter("htnn.plugin.invocations")
+	pluginLocalReplies = api.DefineCounter("htnn.plugin.local_replies")
+	pluginLatency      = api.DefineCounter("htnn.plugin.latency_us")
+
+	pluginPhaseMetricsCache sync.Map
+)
+
+type phaseMetrics struct {
+	invocations api.Counter
+	latency     api.Counter
+}
+
+// pluginPhaseMetrics contains the metrics with the tags of the plugin and the phase.
+// We compute them in advance to avoid building the tags for each call.
+// Like the skip check in the filtermanager, only the phases implemented by the plugin
+// are recorded, so the calls to the methods inherited from the PassThroughFilter are not counted.
+type pluginPhaseMetrics map[api.Phase]*phaseMetrics
+
+func loadPluginPhaseMetrics(name string, plugin api.Filter) pluginPhaseMetrics {
+	if m, ok := pluginPhaseMetricsCache.Load(name); ok {
+		return m.(pluginPhaseMetrics)
+	}
+
+	m := make(pluginPhaseMetrics)
+	for meth := range api.NewAllMethodsMap() {
+		overridden, err := reflectx.IsMethodOverridden(plugin, meth)
+		if err == nil && !overridden {
+			continue
+		}
+
+		tags := []api.MetricTag{
+			{Key: "plugin", Value: name},
+			{Key: "phase", Value: meth},
+		}
+		m[api.MethodToPhase(meth)] = &phaseMetrics{
+			invocations: pluginInvocations.WithTags(tags...),
+			latency:     pluginLatency.WithTags(tags...),
+		}
+	}
+	actual, _ := pluginPhaseMetricsCache.LoadOrStore(name, m)
+	return actual.(pluginPhaseMetrics)
+}
+
+func recordPluginLocalReply(name string, code int) {
+	pluginLocalReplies.WithTags(
+		api.MetricTag{Key: "plugin", Value: name},
+		api.MetricTag{Key: "code", Value: strconv.Itoa(code)},
+	).Increment(1)
+}
+
+type metricsFilter struct {
+	// Don't inherit the PassThroughFilter
+	internal api.Filter
+	metrics  pluginPhaseMetrics
+}
+
+func NewMetricsFilter(name string, internal api.Filter) api.Filter {
+	plugin := internal
+	if tf, ok := internal.(*timeoutFilter); ok {
+		plugin = tf.internal
+	}
+	return &metricsFilter{
+		internal: internal,
+		metrics:  loadPluginPhaseMetrics(name, plugin),
+	}
+}
+
+func (f *metricsFilter) record(phase api.Phase, start time.Time) {
+	m, ok := f.metrics[phase]
+	if !ok {
+		return
+	}
+	m.invocations.Increment(1)
+	m.latency.Increment(time.Since(start).Microseconds())
+}
+
+func (f *metricsFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	defer f.record(api.PhaseDecodeHeaders, time.Now())
+	return f.internal.DecodeHeaders(headers, endStream)
+}
+
+func (f *metricsFilter) DecodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	defer f.record(api.PhaseDecodeData, time.Now())
+	return f.internal.DecodeData(data, endStream)
+}
+
+func (f *metricsFilter) DecodeTrailers(trailers api.RequestTrailerMap) api.ResultAction {
+	defer f.record(api.PhaseDecodeTrailers, time.Now())
+	return f.internal.DecodeTrailers(trailers)
+}
+
+func (f *metricsFilter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	defer f.record(api.PhaseEncodeHeaders, time.Now())
+	return f.internal.EncodeHeaders(headers, endStream)
+}
+
+func (f *metricsFilter) EncodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	defer f.record(api.PhaseEncodeData, time.Now())
+	return f.internal.EncodeData(data, endStream)
+}
+
+func (f *metricsFilter) EncodeTrailers(trailers api.ResponseTrailerMap) api.ResultAction {
+	defer f.record(api.PhaseEncodeTrailers, time.Now())
+	return f.internal.EncodeTrailers(trailers)
+}
+
+func (f *metricsFilter) OnLog(reqHeaders api.RequestHeaderMap, reqTrailers api.RequestTrailerMap,
+	respHeaders api.ResponseHeaderMap, respTrailers api.ResponseTrailerMap) {
+
+	defer f.record(api.PhaseOnLog, time.Now())
+	f.internal.OnLog(reqHeaders, reqTrailers, respHeaders, respTrailers)
+}
+
+func (f *metricsFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	defer f.record(api.PhaseDecodeRequest, time.Now())
+	return f.internal.DecodeRequest(headers, data, trailers)
+}
+
+func (f *metricsFilter) EncodeResponse(headers api.ResponseHeaderMap, data api.BufferInstance, trailers api.ResponseTrailerMap) api.ResultAction {
+	defer f.record(api.PhaseEncodeResponse, time.Now())
+	return f.internal.EncodeResponse(headers, data, trailers)
+}
@@ -27,6 +27,12 @@ import (
 type FilterConfig struct {
 	Name   string      `json:"name,omitempty"`
 	Config interface{} `json:"config,omitempty"`
+	// Timeout limits the time spent by the plugin in each phase, like "100ms".
+	// No limit if it's not set.
+	Timeout string `json:"timeout,omitempty"`
+	// Match is a CEL expression which returns bool. The plugin is only run when the expression
+	// is evaluated to true. The plugin is always run if it's not set.
+	Match string `json:"match,omitempty"`
 }
 
 type ParsedFilterConfig struct {
@@ -36,6 +42,25 @@ type ParsedFilterConfig struct {
 	InitFailure   error
 	Factory       api.FilterFactory
 	SyncRunPhases api.Phase
+	Timeout       time.Duration
+	Matcher       PluginMatcher
+}
+
+// PluginMatcher decides whether the plugin should be run for the request.
+type PluginMatcher interface {
+	Match(callbacks api.FilterCallbackHandler, headers api.RequestHeaderMap) (bool, error)
+}
+
+// PluginTimeoutConfig controls what to do when a plugin exceeds its timeout.
+type PluginTimeoutConfig struct {
+	// FailOpen continues the processing as if the plugin returns api.Continue. The processing is
+	// continued right after the deadline, and the writes of the timed-out plugin are dropped.
+	FailOpen bool `json:"failOpen,omitempty"`
+	// StatusCode is the status code of the local response sent when FailOpen is not set.
+	// Default to 504.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Msg is the message of the local response sent when FailOpen is not set.
+	Msg string `json:"msg,omitempty"`
 }
 
 type FilterWrapper struct {
@@ -0,0 +1,249 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// timeoutCallbackHandler is given to the plugin which has a timeout, so that the plugin can get
+// the context of the running phase.
+type timeoutCallbackHandler struct {
+	*filterManagerCallbackHandler
+
+	ctxLock sync.Mutex
+	ctx     context.Context
+}
+
+func newTimeoutCallbackHandler(cb *filterManagerCallbackHandler) *timeoutCallbackHandler {
+	return &timeoutCallbackHandler{
+		filterManagerCallbackHandler: cb,
+		ctx:                          context.Background(),
+	}
+}
+
+func (cb *timeoutCallbackHandler) Context() context.Context {
+	cb.ctxLock.Lock()
+	defer cb.ctxLock.Unlock()
+	return cb.ctx
+}
+
+func (cb *timeoutCallbackHandler) setContext(ctx context.Context) {
+	cb.ctxLock.Lock()
+	cb.ctx = ctx
+	cb.ctxLock.Unlock()
+}
+
+type timeoutFilter struct {
+	// Don't inherit the PassThroughFilter
+	name      string
+	internal  api.Filter
+	callbacks *timeoutCallbackHandler
+	timeout   time.Duration
+	fm        *filterManager
+
+	// timedOut is closed when the timed-out plugin returns
+	timedOut chan struct{}
+}
+
+func NewTimeoutFilter(name string, internal api.Filter, callbacks *timeoutCallbackHandler,
+	timeout time.Duration, fm *filterManager) api.Filter {
+
+	return &timeoutFilter{
+		name:      name,
+		internal:  internal,
+		callbacks: callbacks,
+		timeout:   timeout,
+		fm:        fm,
+	}
+}
+
+// run executes the method in a new goroutine, and stops waiting for it once the deadline is exceeded.
+// The Go API doesn't allow us to interrupt a running goroutine, so the plugin should watch the
+// context to stop the work as soon as possible.
+//
+// After the deadline, the processing moves on at once, no matter the request is failed or continued.
+// The guard is expired so the timed-out plugin can't touch the headers and the body anymore,
+// and the later phases of this plugin are skipped until it returns.
+func (f *timeoutFilter) run(phase api.Phase, guard *timeoutGuard, call func() api.ResultAction) api.ResultAction {
+	if f.stillRunning() {
+		api.LogWarnf("plugin %s is still running after timeout, skip phase %s", f.name, phase)
+		return api.Continue
+	}
+
+	// inherit the span from the callbacks
+	ctx, cancel := context.WithTimeout(f.callbacks.filterManagerCallbackHandler.Context(), f.timeout)
+	f.callbacks.setContext(ctx)
+
+	done := make(chan api.ResultAction, 1)
+	exited := make(chan struct{})
+	// Prevent the filter manager from being recycled if the plugin is still running after timeout
+	f.fm.MarkRunningInGoThread(true)
+	go func() {
+		defer f.fm.MarkRunningInGoThread(false)
+		defer close(exited)
+		defer cancel()
+		defer func() {
+			if p := recover(); p != nil {
+				api.LogErrorf("panic: %v\n%s", p, debug.Stack())
+				done <- &api.LocalResponse{Code: 500}
+			}
+		}()
+
+		done <- call()
+	}()
+
+	select {
+	case res := <-done:
+		return res
+	case <-ctx.Done():
+	}
+
+	// wait for the ongoing access to the headers and the body, and reject the later ones
+	guard.expire()
+	f.timedOut = exited
+	// the result of the timed-out plugin is ignored
+	return f.fm.handlePluginTimeout(f.name, phase)
+}
+
+func (f *timeoutFilter) stillRunning() bool {
+	if f.timedOut == nil {
+		return false
+	}
+	select {
+	case <-f.timedOut:
+		f.timedOut = nil
+		return false
+	default:
+		return true
+	}
+}
+
+func (f *timeoutFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	g := &timeoutGuard{}
+	headers = newGuardedRequestHeaderMap(headers, g)
+	return f.run(api.PhaseDecodeHeaders, g, func() api.ResultAction {
+		return f.internal.DecodeHeaders(headers, endStream)
+	})
+}
+
+func (f *timeoutFilter) DecodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	g := &timeoutGuard{}
+	data = newGuardedBuffer(data, g)
+	return f.run(api.PhaseDecodeData, g, func() api.ResultAction {
+		return f.internal.DecodeData(data, endStream)
+	})
+}
+
+func (f *timeoutFilter) DecodeTrailers(trailers api.RequestTrailerMap) api.ResultAction {
+	g := &timeoutGuard{}
+	trailers = newGuardedTrailerMap(trailers, g)
+	return f.run(api.PhaseDecodeTrailers, g, func() api.ResultAction {
+		return f.internal.DecodeTrailers(trailers)
+	})
+}
+
+func (f *timeoutFilter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	g := &timeoutGuard{}
+	headers = newGuardedResponseHeaderMap(headers, g)
+	return f.run(api.PhaseEncodeHeaders, g, func() api.ResultAction {
+		return f.internal.EncodeHeaders(headers, endStream)
+	})
+}
+
+func (f *timeoutFilter) EncodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	g := &timeoutGuard{}
+	data = newGuardedBuffer(data, g)
+	return f.run(api.PhaseEncodeData, g, func() api.ResultAction {
+		return f.internal.EncodeData(data, endStream)
+	})
+}
+
+func (f *timeoutFilter) EncodeTrailers(trailers api.ResponseTrailerMap) api.ResultAction {
+	g := &timeoutGuard{}
+	trailers = newGuardedTrailerMap(trailers, g)
+	return f.run(api.PhaseEncodeTrailers, g, func() api.ResultAction {
+		return f.internal.EncodeTrailers(trailers)
+	})
+}
+
+func (f *timeoutFilter) OnLog(reqHeaders api.RequestHeaderMap, reqTrailers api.RequestTrailerMap,
+	respHeaders api.ResponseHeaderMap, respTrailers api.ResponseTrailerMap) {
+
+	if f.stillRunning() {
+		// the plugin may still touch its own state
+		api.LogWarnf("plugin %s is still running after timeout, skip phase OnLog", f.name)
+		return
+	}
+	// The OnLog phase doesn't block the request, so we don't limit it
+	f.internal.OnLog(reqHeaders, reqTrailers, respHeaders, respTrailers)
+}
+
+func (f *timeoutFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	g := &timeoutGuard{}
+	headers = newGuardedRequestHeaderMap(headers, g)
+	if data != nil {
+		data = newGuardedBuffer(data, g)
+	}
+	if trailers != nil {
+		trailers = newGuardedTrailerMap(trailers, g)
+	}
+	return f.run(api.PhaseDecodeRequest, g, func() api.ResultAction {
+		return f.internal.DecodeRequest(headers, data, trailers)
+	})
+}
+
+func (f *timeoutFilter) EncodeResponse(headers api.ResponseHeaderMap, data api.BufferInstance, trailers api.ResponseTrailerMap) api.ResultAction {
+	g := &timeoutGuard{}
+	headers = newGuardedResponseHeaderMap(headers, g)
+	if data != nil {
+		data = newGuardedBuffer(data, g)
+	}
+	if trailers != nil {
+		trailers = newGuardedTrailerMap(trailers, g)
+	}
+	return f.run(api.PhaseEncodeResponse, g, func() api.ResultAction {
+		return f.internal.EncodeResponse(headers, data, trailers)
+	})
+}
+
+func (m *filterManager) handlePluginTimeout(name string, phase api.Phase) api.ResultAction {
+	// We can get the plugin name which exceeds the timeout from the dynamic metadata.
+	// For example, use %DYNAMIC_METADATA(htnn:timeout_plugin_name)% in the access log format.
+	m.callbacks.StreamInfo().DynamicMetadata().Set("htnn", "timeout_plugin_name", name)
+
+	cfg := m.config.pluginTimeout
+	if cfg != nil && cfg.FailOpen {
+		api.LogWarnf("plugin %s exceeds timeout in phase %s, continue the processing", name, phase)
+		return api.Continue
+	}
+
+	api.LogErrorf("plugin %s exceeds timeout in phase %s", name, phase)
+	code := http.StatusGatewayTimeout
+	msg := ""
+	if cfg != nil {
+		if cfg.StatusCode != 0 {
+			code = cfg.StatusCode
+		}
+		msg = cfg.Msg
+	}
+	return &api.LocalResponse{Code: code, Msg: msg}
+}
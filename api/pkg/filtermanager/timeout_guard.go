@@ -0,0 +1,418 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// timeoutGuard stops the timed-out plugin from touching the headers and the body, as the
+// processing has moved on. Once the guard is expired, the reads return zero values and the
+// writes are dropped.
+type timeoutGuard struct {
+	lock    sync.RWMutex
+	expired bool
+}
+
+func (g *timeoutGuard) enter() bool {
+	g.lock.RLock()
+	if g.expired {
+		g.lock.RUnlock()
+		return false
+	}
+	return true
+}
+
+func (g *timeoutGuard) leave() {
+	g.lock.RUnlock()
+}
+
+// expire waits for the running access to finish, and rejects the later ones
+func (g *timeoutGuard) expire() {
+	g.lock.Lock()
+	g.expired = true
+	g.lock.Unlock()
+}
+
+type guardedHeaderMap struct {
+	headers api.HeaderMap
+	guard   *timeoutGuard
+}
+
+func (h *guardedHeaderMap) GetRaw(name string) string {
+	if !h.guard.enter() {
+		return ""
+	}
+	defer h.guard.leave()
+	return h.headers.GetRaw(name)
+}
+
+func (h *guardedHeaderMap) Get(key string) (string, bool) {
+	if !h.guard.enter() {
+		return "", false
+	}
+	defer h.guard.leave()
+	return h.headers.Get(key)
+}
+
+func (h *guardedHeaderMap) Values(key string) []string {
+	if !h.guard.enter() {
+		return nil
+	}
+	defer h.guard.leave()
+	vals := h.headers.Values(key)
+	if vals == nil {
+		return nil
+	}
+	return append([]string(nil), vals...)
+}
+
+func (h *guardedHeaderMap) Set(key, value string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.headers.Set(key, value)
+}
+
+func (h *guardedHeaderMap) Add(key, value string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.headers.Add(key, value)
+}
+
+func (h *guardedHeaderMap) Del(key string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.headers.Del(key)
+}
+
+type headerPair struct {
+	key   string
+	value string
+}
+
+// copyHeaders copies the headers under the guard, so that the callback of Range can access
+// the headers without holding the guard.
+func (h *guardedHeaderMap) copyHeaders() []headerPair {
+	if !h.guard.enter() {
+		return nil
+	}
+	defer h.guard.leave()
+	var pairs []headerPair
+	h.headers.RangeWithCopy(func(key, value string) bool {
+		pairs = append(pairs, headerPair{key: key, value: value})
+		return true
+	})
+	return pairs
+}
+
+func (h *guardedHeaderMap) Range(f func(key, value string) bool) {
+	for _, p := range h.copyHeaders() {
+		if !f(p.key, p.value) {
+			return
+		}
+	}
+}
+
+func (h *guardedHeaderMap) RangeWithCopy(f func(key, value string) bool) {
+	h.Range(f)
+}
+
+func (h *guardedHeaderMap) GetAllHeaders() map[string][]string {
+	if !h.guard.enter() {
+		return map[string][]string{}
+	}
+	defer h.guard.leave()
+	all := h.headers.GetAllHeaders()
+	cp := make(map[string][]string, len(all))
+	for k, v := range all {
+		cp[k] = append([]string(nil), v...)
+	}
+	return cp
+}
+
+type guardedRequestHeaderMap struct {
+	guardedHeaderMap
+
+	reqHeaders api.RequestHeaderMap
+}
+
+func newGuardedRequestHeaderMap(headers api.RequestHeaderMap, guard *timeoutGuard) api.RequestHeaderMap {
+	return &guardedRequestHeaderMap{
+		guardedHeaderMap: guardedHeaderMap{headers: headers, guard: guard},
+		reqHeaders:       headers,
+	}
+}
+
+func (h *guardedRequestHeaderMap) Scheme() string {
+	if !h.guard.enter() {
+		return ""
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Scheme()
+}
+
+func (h *guardedRequestHeaderMap) Method() string {
+	if !h.guard.enter() {
+		return ""
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Method()
+}
+
+func (h *guardedRequestHeaderMap) Host() string {
+	if !h.guard.enter() {
+		return ""
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Host()
+}
+
+func (h *guardedRequestHeaderMap) Path() string {
+	if !h.guard.enter() {
+		return ""
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Path()
+}
+
+func (h *guardedRequestHeaderMap) SetMethod(method string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.reqHeaders.SetMethod(method)
+}
+
+func (h *guardedRequestHeaderMap) SetHost(host string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.reqHeaders.SetHost(host)
+}
+
+func (h *guardedRequestHeaderMap) SetPath(path string) {
+	if !h.guard.enter() {
+		return
+	}
+	defer h.guard.leave()
+	h.reqHeaders.SetPath(path)
+}
+
+func (h *guardedRequestHeaderMap) URL() *url.URL {
+	if !h.guard.enter() {
+		return &url.URL{}
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.URL()
+}
+
+func (h *guardedRequestHeaderMap) Cookie(name string) *http.Cookie {
+	if !h.guard.enter() {
+		return nil
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Cookie(name)
+}
+
+func (h *guardedRequestHeaderMap) Cookies() []*http.Cookie {
+	if !h.guard.enter() {
+		return nil
+	}
+	defer h.guard.leave()
+	return h.reqHeaders.Cookies()
+}
+
+type guardedResponseHeaderMap struct {
+	guardedHeaderMap
+
+	respHeaders api.ResponseHeaderMap
+}
+
+func newGuardedResponseHeaderMap(headers api.ResponseHeaderMap, guard *timeoutGuard) api.ResponseHeaderMap {
+	return &guardedResponseHeaderMap{
+		guardedHeaderMap: guardedHeaderMap{headers: headers, guard: guard},
+		respHeaders:      headers,
+	}
+}
+
+func (h *guardedResponseHeaderMap) Status() (int, bool) {
+	if !h.guard.enter() {
+		return 0, false
+	}
+	defer h.guard.leave()
+	return h.respHeaders.Status()
+}
+
+// newGuardedTrailerMap guards both the request trailers and the response trailers, as they
+// are the same as HeaderMap.
+func newGuardedTrailerMap(trailers api.HeaderMap, guard *timeoutGuard) *guardedHeaderMap {
+	return &guardedHeaderMap{headers: trailers, guard: guard}
+}
+
+type guardedBuffer struct {
+	buf   api.BufferInstance
+	guard *timeoutGuard
+}
+
+func newGuardedBuffer(buf api.BufferInstance, guard *timeoutGuard) api.BufferInstance {
+	return &guardedBuffer{buf: buf, guard: guard}
+}
+
+func (b *guardedBuffer) Write(p []byte) (int, error) {
+	if !b.guard.enter() {
+		return len(p), nil
+	}
+	defer b.guard.leave()
+	return b.buf.Write(p)
+}
+
+func (b *guardedBuffer) WriteString(s string) (int, error) {
+	if !b.guard.enter() {
+		return len(s), nil
+	}
+	defer b.guard.leave()
+	return b.buf.WriteString(s)
+}
+
+func (b *guardedBuffer) WriteByte(p byte) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.WriteByte(p)
+}
+
+func (b *guardedBuffer) WriteUint16(p uint16) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.WriteUint16(p)
+}
+
+func (b *guardedBuffer) WriteUint32(p uint32) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.WriteUint32(p)
+}
+
+func (b *guardedBuffer) WriteUint64(p uint64) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.WriteUint64(p)
+}
+
+func (b *guardedBuffer) Bytes() []byte {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.Bytes()
+}
+
+func (b *guardedBuffer) Drain(offset int) {
+	if !b.guard.enter() {
+		return
+	}
+	defer b.guard.leave()
+	b.buf.Drain(offset)
+}
+
+func (b *guardedBuffer) Len() int {
+	if !b.guard.enter() {
+		return 0
+	}
+	defer b.guard.leave()
+	return b.buf.Len()
+}
+
+func (b *guardedBuffer) Reset() {
+	if !b.guard.enter() {
+		return
+	}
+	defer b.guard.leave()
+	b.buf.Reset()
+}
+
+func (b *guardedBuffer) String() string {
+	if !b.guard.enter() {
+		return ""
+	}
+	defer b.guard.leave()
+	return b.buf.String()
+}
+
+func (b *guardedBuffer) Append(data []byte) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.Append(data)
+}
+
+func (b *guardedBuffer) Set(data []byte) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.Set(data)
+}
+
+func (b *guardedBuffer) SetString(s string) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.SetString(s)
+}
+
+func (b *guardedBuffer) Prepend(data []byte) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.Prepend(data)
+}
+
+func (b *guardedBuffer) PrependString(s string) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.PrependString(s)
+}
+
+func (b *guardedBuffer) AppendString(s string) error {
+	if !b.guard.enter() {
+		return nil
+	}
+	defer b.guard.leave()
+	return b.buf.AppendString(s)
+}
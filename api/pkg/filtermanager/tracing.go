@@ -0,0 +1,162 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"strconv"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/tracing"
+)
+
+func (m *filterManager) extractTraceParent(headers api.RequestHeaderMap) {
+	sc, ok := tracing.Extract(func(key string) string {
+		v, _ := headers.Get(key)
+		return v
+	})
+	if !ok {
+		// The request doesn't carry a trace context, start a new trace if it's sampled.
+		// All the plugin spans of the request share the same root span.
+		sc, ok = tracing.NewRootSpanContext()
+		if ok {
+			span := tracing.StartSpan(sc, "htnn request", tracing.SpanKindInternal)
+			sc = span.SpanContext()
+			// The OnLog phase may be run concurrently when the request is aborted
+			m.hdrLock.Lock()
+			m.requestSpan = span
+			m.hdrLock.Unlock()
+		}
+	}
+	if ok {
+		m.traceParent = sc
+	}
+}
+
+// endRequestSpan ends the root span started for the request. It's called in the OnLog phase,
+// which is run after the request is finished. As the filterManager may be recycled after
+// the OnLog phase, we don't wait for the OnDestroy.
+func (m *filterManager) endRequestSpan() {
+	m.hdrLock.Lock()
+	span := m.requestSpan
+	m.requestSpan = nil
+	m.hdrLock.Unlock()
+	// End can be called with nil
+	span.End()
+}
+
+type tracingFilter struct {
+	// Don't inherit the PassThroughFilter
+	name     string
+	internal api.Filter
+	fm       *filterManager
+}
+
+func NewTracingFilter(name string, internal api.Filter, fm *filterManager) api.Filter {
+	return &tracingFilter{
+		name:     name,
+		internal: internal,
+		fm:       fm,
+	}
+}
+
+// start creates a child span of the request for the plugin's method. The span is
+// available via the callbacks.Context() when the method is running.
+func (f *tracingFilter) start(method string) *tracing.Span {
+	span := tracing.StartSpan(f.fm.traceParent, f.name+" "+method, tracing.SpanKindInternal)
+	if span == nil {
+		return nil
+	}
+	span.SetAttribute("htnn.plugin", f.name)
+	span.SetAttribute("htnn.phase", method)
+	f.fm.callbacks.setSpan(span)
+	return span
+}
+
+func (f *tracingFilter) end(span *tracing.Span, res api.ResultAction) {
+	if span == nil {
+		return
+	}
+	if v, ok := res.(*api.LocalResponse); ok {
+		span.SetAttribute("http.status_code", strconv.Itoa(v.Code))
+		if v.Code >= 500 {
+			span.SetError("local reply with status code " + strconv.Itoa(v.Code))
+		}
+	}
+	f.fm.callbacks.setSpan(nil)
+	span.End()
+}
+
+func (f *tracingFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	span := f.start("DecodeHeaders")
+	res := f.internal.DecodeHeaders(headers, endStream)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) DecodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	span := f.start("DecodeData")
+	res := f.internal.DecodeData(data, endStream)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) DecodeTrailers(trailers api.RequestTrailerMap) api.ResultAction {
+	span := f.start("DecodeTrailers")
+	res := f.internal.DecodeTrailers(trailers)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	span := f.start("EncodeHeaders")
+	res := f.internal.EncodeHeaders(headers, endStream)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) EncodeData(data api.BufferInstance, endStream bool) api.ResultAction {
+	span := f.start("EncodeData")
+	res := f.internal.EncodeData(data, endStream)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) EncodeTrailers(trailers api.ResponseTrailerMap) api.ResultAction {
+	span := f.start("EncodeTrailers")
+	res := f.internal.EncodeTrailers(trailers)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) OnLog(reqHeaders api.RequestHeaderMap, reqTrailers api.RequestTrailerMap,
+	respHeaders api.ResponseHeaderMap, respTrailers api.ResponseTrailerMap) {
+
+	// The OnLog phase is run after the request is finished, so we don't trace it
+	f.internal.OnLog(reqHeaders, reqTrailers, respHeaders, respTrailers)
+}
+
+func (f *tracingFilter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	span := f.start("DecodeRequest")
+	res := f.internal.DecodeRequest(headers, data, trailers)
+	f.end(span, res)
+	return res
+}
+
+func (f *tracingFilter) EncodeResponse(headers api.ResponseHeaderMap, data api.BufferInstance, trailers api.ResponseTrailerMap) api.ResultAction {
+	span := f.start("EncodeResponse")
+	res := f.internal.EncodeResponse(headers, data, trailers)
+	f.end(span, res)
+	return res
+}
@@ -0,0 +1,242 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermanager
+
+import (
+	"bytes"
+	"strconv"
+
+	capi "github.com/envoyproxy/envoy/contrib/golang/common/go/api"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+)
+
+type bodyTransformer struct {
+	api.BodyTransformer
+
+	maxLookahead int
+	rest         []byte
+	flushed      bool
+}
+
+func newBodyTransformer(v *api.TransformBody) *bodyTransformer {
+	maxLookahead := v.MaxLookahead
+	if maxLookahead <= 0 {
+		maxLookahead = api.DefaultMaxLookahead
+	}
+	return &bodyTransformer{
+		BodyTransformer: v.Transformer,
+		maxLookahead:    maxLookahead,
+	}
+}
+
+// transform rewrites the buffer in place. It returns false if the data held back is over the limit.
+func (t *bodyTransformer) transform(buf capi.BufferInstance, endStream bool) bool {
+	if t.flushed {
+		return true
+	}
+
+	data := buf.Bytes()
+	if len(t.rest) > 0 {
+		data = append(t.rest, data...)
+	}
+	output, rest := t.Transform(data)
+	if len(rest) > t.maxLookahead {
+		return false
+	}
+	// the rest may refer to the memory which will be reused, so we need to copy it
+	t.rest = append([]byte(nil), rest...)
+
+	if endStream {
+		output = append(output, t.flush()...)
+	}
+	_ = buf.Set(output)
+	return true
+}
+
+func (t *bodyTransformer) flush() []byte {
+	if t.flushed {
+		return nil
+	}
+	t.flushed = true
+	rest := t.rest
+	t.rest = nil
+	return t.Flush(rest)
+}
+
+func (m *filterManager) addBodyTransformer(v *api.TransformBody, phase api.Phase, filter *model.FilterWrapper) {
+	if v.Transformer == nil {
+		api.LogErrorf("TransformBody without Transformer returned from %s", filter.Name)
+		return
+	}
+
+	switch phase {
+	case api.PhaseDecodeHeaders:
+		if m.decodeTransformers == nil {
+			m.decodeTransformers = make(map[*model.FilterWrapper]*bodyTransformer, 1)
+		}
+		m.decodeTransformers[filter] = newBodyTransformer(v)
+		m.reqHdr.Del("content-length")
+		// The transformer is not known when the filter manager is created, so we need to
+		// turn off the optimizations which are based on the method definitions.
+		m.canSkipDecodeData = false
+		m.canSkipDecodeTrailers = false
+		m.canSyncRunDecodeData = false
+		m.canSyncRunDecodeTrailers = false
+	case api.PhaseEncodeHeaders:
+		if m.encodeTransformers == nil {
+			m.encodeTransformers = make(map[*model.FilterWrapper]*bodyTransformer, 1)
+		}
+		m.encodeTransformers[filter] = newBodyTransformer(v)
+		m.rspHdr.Del("content-length")
+		m.canSkipEncodeData = false
+		m.canSkipEncodeTrailers = false
+		m.canSyncRunEncodeData = false
+		m.canSyncRunEncodeTrailers = false
+	default:
+		api.LogErrorf("TransformBody only allowed when processing headers, phase: %v", phase)
+	}
+}
+
+func (m *filterManager) transformData(transformers map[*model.FilterWrapper]*bodyTransformer,
+	buf capi.BufferInstance, endStream bool, phase api.Phase, filter *model.FilterWrapper) (needReturn bool) {
+
+	t := transformers[filter]
+	if t == nil {
+		return false
+	}
+	if !t.transform(buf, endStream) {
+		api.LogErrorf("data held back by the body transformer of plugin %s is over the limit %d", filter.Name, t.maxLookahead)
+		return m.handleAction(&api.LocalResponse{Code: 500}, phase, filter)
+	}
+	return false
+}
+
+// flushTransformers is called before the trailers phase when the body ends with trailers and
+// is processed streamingly. The data flushed from each transformer is passed through the
+// DecodeData/EncodeData of the later filters, just like the other data. Then it is sent to the
+// next Envoy filter via AddData.
+func (m *filterManager) flushTransformers(transformers map[*model.FilterWrapper]*bodyTransformer, decoding bool) (needReturn bool) {
+	if len(transformers) == 0 {
+		return false
+	}
+
+	n := len(m.filters)
+	phase := api.PhaseDecodeData
+	if !decoding {
+		phase = api.PhaseEncodeData
+	}
+	var tail *dataBuffer
+	for k := 0; k < n; k++ {
+		i := k
+		if !decoding {
+			i = n - 1 - k
+		}
+		f := m.filters[i]
+		if tail != nil {
+			var res api.ResultAction
+			if decoding {
+				res = f.DecodeData(tail, false)
+			} else {
+				res = f.EncodeData(tail, false)
+			}
+			if m.handleAction(res, phase, f) {
+				return true
+			}
+			if m.transformData(transformers, tail, false, phase, f) {
+				return true
+			}
+		}
+
+		t := transformers[f]
+		if t == nil {
+			continue
+		}
+		data := t.flush()
+		if len(data) > 0 {
+			if tail == nil {
+				tail = &dataBuffer{}
+			}
+			_ = tail.Append(data)
+		}
+	}
+
+	if tail == nil || tail.Len() == 0 {
+		return false
+	}
+	var cb api.FilterProcessCallbacks
+	if decoding {
+		cb = m.callbacks.DecoderFilterCallbacks()
+	} else {
+		cb = m.callbacks.EncoderFilterCallbacks()
+	}
+	cb.AddData(tail.Bytes(), true)
+	return false
+}
+
+// dataBuffer holds the data flushed from the transformers
+type dataBuffer struct {
+	bytes.Buffer
+}
+
+var _ capi.BufferInstance = (*dataBuffer)(nil)
+
+func (b *dataBuffer) WriteUint16(p uint16) error {
+	_, err := b.WriteString(strconv.FormatUint(uint64(p), 10))
+	return err
+}
+
+func (b *dataBuffer) WriteUint32(p uint32) error {
+	_, err := b.WriteString(strconv.FormatUint(uint64(p), 10))
+	return err
+}
+
+func (b *dataBuffer) WriteUint64(p uint64) error {
+	_, err := b.WriteString(strconv.FormatUint(p, 10))
+	return err
+}
+
+func (b *dataBuffer) Drain(offset int) {
+	b.Next(offset)
+}
+
+func (b *dataBuffer) Append(data []byte) error {
+	_, err := b.Write(data)
+	return err
+}
+
+func (b *dataBuffer) AppendString(s string) error {
+	_, err := b.WriteString(s)
+	return err
+}
+
+func (b *dataBuffer) Set(data []byte) error {
+	b.Buffer.Reset()
+	_, err := b.Write(data)
+	return err
+}
+
+func (b *dataBuffer) SetString(s string) error {
+	return b.Set([]byte(s))
+}
+
+func (b *dataBuffer) Prepend(data []byte) error {
+	return b.Set(append(append([]byte(nil), data...), b.Bytes()...))
+}
+
+func (b *dataBuffer) PrependString(s string) error {
+	return b.Prepend([]byte(s))
+}
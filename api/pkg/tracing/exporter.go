@@ -0,0 +1,164 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mosn.io/htnn/api/pkg/log"
+)
+
+var (
+	logger = log.DefaultLogger.WithName("tracing")
+
+	currentProcessor atomic.Pointer[batchProcessor]
+)
+
+// Exporter sends the finished spans to the backend
+type Exporter interface {
+	ExportSpans(ctx context.Context, spans []*SpanData) error
+}
+
+const (
+	defaultQueueSize     = 4096
+	defaultBatchSize     = 512
+	defaultFlushInterval = time.Second
+	defaultExportTimeout = 10 * time.Second
+)
+
+type batchProcessor struct {
+	exporter Exporter
+	queue    chan *SpanData
+	stop     chan struct{}
+	done     sync.WaitGroup
+}
+
+func newBatchProcessor(exporter Exporter) *batchProcessor {
+	p := &batchProcessor{
+		exporter: exporter,
+		queue:    make(chan *SpanData, defaultQueueSize),
+		stop:     make(chan struct{}),
+	}
+	p.done.Add(1)
+	go p.run()
+	return p
+}
+
+func (p *batchProcessor) run() {
+	defer p.done.Done()
+
+	ticker := time.NewTicker(defaultFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, defaultBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), defaultExportTimeout)
+		if err := p.exporter.ExportSpans(ctx, batch); err != nil {
+			logger.Error(err, "failed to export spans", "count", len(batch))
+		}
+		cancel()
+		batch = make([]*SpanData, 0, defaultBatchSize)
+	}
+
+	for {
+		select {
+		case span := <-p.queue:
+			batch = append(batch, span)
+			if len(batch) >= defaultBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-p.stop:
+			for {
+				select {
+				case span := <-p.queue:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *batchProcessor) enqueue(span *SpanData) {
+	select {
+	case p.queue <- span:
+	default:
+		// Don't block the request when the exporter can't catch up
+		logger.Info("drop span as the queue is full", "name", span.Name)
+	}
+}
+
+func (p *batchProcessor) shutdown() {
+	close(p.stop)
+	p.done.Wait()
+}
+
+// SetExporter sets the exporter of the finished spans. The spans are exported in batch.
+// Setting a nil exporter disables the tracing. The spans not exported by the previous
+// exporter will be flushed before this function returns.
+func SetExporter(exporter Exporter) {
+	var p *batchProcessor
+	if exporter != nil {
+		p = newBatchProcessor(exporter)
+	}
+	if prev := currentProcessor.Swap(p); prev != nil {
+		prev.shutdown()
+	}
+}
+
+// Enabled returns true if an exporter is set
+func Enabled() bool {
+	return currentProcessor.Load() != nil
+}
+
+func export(span *SpanData) {
+	if p := currentProcessor.Load(); p != nil {
+		p.enqueue(span)
+	}
+}
+
+func init() {
+	// Follow the environment variables defined in the OpenTelemetry specification
+	setRootSamplerFromEnv(os.Getenv("OTEL_TRACES_SAMPLER"), os.Getenv("OTEL_TRACES_SAMPLER_ARG"))
+
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if endpoint == "" {
+		endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		if endpoint != "" {
+			endpoint = strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+		}
+	}
+	if endpoint == "" {
+		return
+	}
+
+	SetExporter(NewOTLPHTTPExporter(OTLPHTTPExporterOptions{
+		Endpoint:    endpoint,
+		Headers:     parseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+		ServiceName: os.Getenv("OTEL_SERVICE_NAME"),
+	}))
+}
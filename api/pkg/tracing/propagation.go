@@ -0,0 +1,149 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+type format int
+
+const (
+	formatW3C format = iota
+	formatB3Single
+	formatB3Multi
+)
+
+const (
+	headerTraceparent = "traceparent"
+	headerB3          = "b3"
+	headerB3TraceID   = "x-b3-traceid"
+	headerB3SpanID    = "x-b3-spanid"
+	headerB3Sampled   = "x-b3-sampled"
+	headerB3Flags     = "x-b3-flags"
+)
+
+// Extract reads the span context from the headers. It supports W3C `traceparent`, single `b3` header
+// and multiple `x-b3-*` headers, in this order.
+func Extract(get func(key string) string) (SpanContext, bool) {
+	if v := get(headerTraceparent); v != "" {
+		return parseTraceparent(v)
+	}
+	if v := get(headerB3); v != "" {
+		return parseB3Single(v)
+	}
+	if v := get(headerB3TraceID); v != "" {
+		sc := SpanContext{format: formatB3Multi}
+		if !decodeTraceID(v, &sc.TraceID) || !decodeHex(get(headerB3SpanID), sc.SpanID[:]) {
+			return SpanContext{}, false
+		}
+		sc.Sampled = isB3Sampled(get(headerB3Sampled)) || get(headerB3Flags) == "1"
+		return sc, sc.IsValid()
+	}
+	return SpanContext{}, false
+}
+
+// Inject writes the span context into the headers, with the same format of the extracted parent.
+func Inject(sc SpanContext, set func(key string, value string)) {
+	if !sc.IsValid() {
+		return
+	}
+
+	switch sc.format {
+	case formatB3Single:
+		sampled := "0"
+		if sc.Sampled {
+			sampled = "1"
+		}
+		set(headerB3, fmt.Sprintf("%s-%s-%s", sc.TraceID, sc.SpanID, sampled))
+	case formatB3Multi:
+		set(headerB3TraceID, sc.TraceID.String())
+		set(headerB3SpanID, sc.SpanID.String())
+		if sc.Sampled {
+			set(headerB3Sampled, "1")
+		} else {
+			set(headerB3Sampled, "0")
+		}
+	default:
+		flags := "00"
+		if sc.Sampled {
+			flags = "01"
+		}
+		set(headerTraceparent, fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags))
+	}
+}
+
+func decodeHex(s string, dst []byte) bool {
+	if len(s) != hex.EncodedLen(len(dst)) {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err == nil
+}
+
+// decodeTraceID decodes the trace id. The 64-bit trace id used in B3 is left-padded with zero.
+func decodeTraceID(s string, dst *TraceID) bool {
+	if len(s) == 16 {
+		s = strings.Repeat("0", 16) + s
+	}
+	return decodeHex(s, dst[:])
+}
+
+func parseTraceparent(v string) (SpanContext, bool) {
+	// version-traceid-spanid-flags
+	parts := strings.Split(strings.TrimSpace(v), "-")
+	if len(parts) < 4 {
+		return SpanContext{}, false
+	}
+	version := parts[0]
+	if len(version) != 2 || version == "ff" || (version == "00" && len(parts) != 4) {
+		return SpanContext{}, false
+	}
+
+	sc := SpanContext{format: formatW3C}
+	var flags [1]byte
+	if !decodeHex(parts[1], sc.TraceID[:]) || !decodeHex(parts[2], sc.SpanID[:]) || !decodeHex(parts[3], flags[:]) {
+		return SpanContext{}, false
+	}
+	sc.Sampled = flags[0]&0x01 == 0x01
+	return sc, sc.IsValid()
+}
+
+func isB3Sampled(v string) bool {
+	// When the sampling state is absent, we follow the decision from the caller
+	return v == "" || v == "1" || v == "true" || v == "d"
+}
+
+func parseB3Single(v string) (SpanContext, bool) {
+	// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}, the last two fields are optional.
+	// A single sampling state like `b3: 0` doesn't contain the context.
+	parts := strings.Split(strings.TrimSpace(v), "-")
+	if len(parts) < 2 {
+		return SpanContext{}, false
+	}
+
+	sc := SpanContext{format: formatB3Single}
+	if !decodeTraceID(parts[0], &sc.TraceID) || !decodeHex(parts[1], sc.SpanID[:]) {
+		return SpanContext{}, false
+	}
+	sampled := ""
+	if len(parts) > 2 {
+		sampled = parts[2]
+	}
+	sc.Sampled = isB3Sampled(sampled)
+	return sc, sc.IsValid()
+}
@@ -0,0 +1,93 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"sync/atomic"
+)
+
+// rootSampleRatio is the float64 bits of the ratio
+var rootSampleRatio atomic.Uint64
+
+// SetRootSampleRatio sets the ratio of the requests which are traced when they don't carry a trace
+// context. The ratio is clamped to [0, 1]. It's 0 by default, so only the requests with a sampled
+// trace context are traced. The sampling decision of the incoming trace context is always respected.
+func SetRootSampleRatio(ratio float64) {
+	if math.IsNaN(ratio) || ratio < 0 {
+		ratio = 0
+	} else if ratio > 1 {
+		ratio = 1
+	}
+	rootSampleRatio.Store(math.Float64bits(ratio))
+}
+
+// RootSampleRatio returns the ratio set by SetRootSampleRatio
+func RootSampleRatio() float64 {
+	return math.Float64frombits(rootSampleRatio.Load())
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	for !id.IsValid() {
+		for i := 0; i < 2; i++ {
+			v := rand.Uint64()
+			for j := 0; j < 8; j++ {
+				id[i*8+j] = byte(v >> (8 * j))
+			}
+		}
+	}
+	return id
+}
+
+// NewRootSpanContext starts a new trace according to the root sample ratio. It returns false if the
+// trace is not sampled. The spans started from the returned context are the root spans of the trace.
+func NewRootSpanContext() (SpanContext, bool) {
+	ratio := RootSampleRatio()
+	if ratio <= 0 || rand.Float64() >= ratio {
+		return SpanContext{}, false
+	}
+	return SpanContext{
+		TraceID: newTraceID(),
+		Sampled: true,
+	}, true
+}
+
+// setRootSamplerFromEnv follows the `OTEL_TRACES_SAMPLER` and `OTEL_TRACES_SAMPLER_ARG` in the
+// OpenTelemetry specification. Only the parent-based samplers are supported.
+func setRootSamplerFromEnv(sampler string, arg string) {
+	switch sampler {
+	case "":
+	case "parentbased_always_on":
+		SetRootSampleRatio(1)
+	case "parentbased_always_off":
+		SetRootSampleRatio(0)
+	case "parentbased_traceidratio":
+		ratio := 1.0
+		if arg != "" {
+			v, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				logger.Error(err, "invalid OTEL_TRACES_SAMPLER_ARG, use 1.0 instead", "arg", arg)
+			} else {
+				ratio = v
+			}
+		}
+		SetRootSampleRatio(ratio)
+	default:
+		logger.Info("unsupported OTEL_TRACES_SAMPLER, ignored", "sampler", sampler)
+	}
+}
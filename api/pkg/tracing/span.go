@@ -0,0 +1,186 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+type TraceID [16]byte
+
+func (t TraceID) IsValid() bool {
+	return t != TraceID{}
+}
+
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+type SpanID [8]byte
+
+func (s SpanID) IsValid() bool {
+	return s != SpanID{}
+}
+
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SpanContext is the part of span which is propagated across the services
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+
+	// format is the propagation format of the parent, we use the same format to inject the context
+	format format
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+type SpanKind int
+
+// The values are the same as the OTLP's
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// SpanData is the finished span given to the Exporter
+type SpanData struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	Name         string
+	Kind         SpanKind
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   map[string]string
+	// Error is the description of the error. The span is considered failed if it's not empty.
+	Error string
+}
+
+type Span struct {
+	lock  sync.Mutex
+	data  SpanData
+	sc    SpanContext
+	ended bool
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	for !id.IsValid() {
+		v := rand.Uint64()
+		for i := 0; i < 8; i++ {
+			id[i] = byte(v >> (8 * i))
+		}
+	}
+	return id
+}
+
+// StartSpan starts a child span of the parent. It returns nil if the parent is invalid or not sampled,
+// so that the caller can skip the tracing work. All the methods of Span can be called with nil.
+// If the parent is created by NewRootSpanContext, the span is a root span.
+func StartSpan(parent SpanContext, name string, kind SpanKind) *Span {
+	if !parent.TraceID.IsValid() || !parent.Sampled {
+		return nil
+	}
+
+	id := newSpanID()
+	return &Span{
+		data: SpanData{
+			TraceID:      parent.TraceID,
+			SpanID:       id,
+			ParentSpanID: parent.SpanID,
+			Name:         name,
+			Kind:         kind,
+			StartTime:    time.Now(),
+		},
+		sc: SpanContext{
+			TraceID: parent.TraceID,
+			SpanID:  id,
+			Sampled: true,
+			format:  parent.format,
+		},
+	}
+}
+
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+func (s *Span) SetAttribute(key string, value string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	if s.data.Attributes == nil {
+		s.data.Attributes = make(map[string]string)
+	}
+	s.data.Attributes[key] = value
+	s.lock.Unlock()
+}
+
+func (s *Span) SetError(msg string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	s.data.Error = msg
+	s.lock.Unlock()
+}
+
+// End finishes the span and sends it to the exporter. Calling End more than once is no-op.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	data := s.data
+	s.lock.Unlock()
+
+	export(&data)
+}
+
+type spanKey struct{}
+
+// ContextWithSpan returns a copy of ctx which carries the span
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the span in the ctx, or nil if there is no span
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
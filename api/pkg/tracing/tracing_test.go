@@ -0,0 +1,308 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAndInject(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		ok      bool
+		sampled bool
+	}{
+		{
+			name: "traceparent",
+			headers: map[string]string{
+				"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+			},
+			ok:      true,
+			sampled: true,
+		},
+		{
+			name: "traceparent, not sampled",
+			headers: map[string]string{
+				"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00",
+			},
+			ok: true,
+		},
+		{
+			name: "bad traceparent",
+			headers: map[string]string{
+				"traceparent": "00-00000000000000000000000000000000-b7ad6b7169203331-01",
+			},
+		},
+		{
+			name: "b3 single",
+			headers: map[string]string{
+				"b3": "80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-1-05e3ac9a4f6e3b90",
+			},
+			ok:      true,
+			sampled: true,
+		},
+		{
+			name: "b3 single, sampling state is absent",
+			headers: map[string]string{
+				"b3": "80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1",
+			},
+			ok:      true,
+			sampled: true,
+		},
+		{
+			name: "b3 single, sampling only",
+			headers: map[string]string{
+				"b3": "0",
+			},
+		},
+		{
+			name: "b3 multi, 64-bit trace id",
+			headers: map[string]string{
+				"x-b3-traceid": "a3ce929d0e0e4736",
+				"x-b3-spanid":  "00f067aa0ba902b7",
+				"x-b3-sampled": "1",
+			},
+			ok:      true,
+			sampled: true,
+		},
+		{
+			name: "b3 multi, not sampled",
+			headers: map[string]string{
+				"x-b3-traceid": "80f198ee56343ba864fe8b2a57d3eff7",
+				"x-b3-spanid":  "e457b5a2e4d86bd1",
+				"x-b3-sampled": "0",
+			},
+			ok: true,
+		},
+		{
+			name: "no context",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, ok := Extract(func(key string) string {
+				return tt.headers[key]
+			})
+			assert.Equal(t, tt.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, tt.sampled, sc.Sampled)
+
+			// inject with the same format
+			injected := map[string]string{}
+			Inject(sc, func(key string, value string) {
+				injected[key] = value
+			})
+			for k := range injected {
+				assert.Contains(t, tt.headers, k)
+			}
+			got, ok := Extract(func(key string) string {
+				return injected[key]
+			})
+			assert.True(t, ok)
+			assert.Equal(t, sc, got)
+		})
+	}
+}
+
+type fakeExporter struct {
+	lock  sync.Mutex
+	spans []*SpanData
+}
+
+func (e *fakeExporter) ExportSpans(_ context.Context, spans []*SpanData) error {
+	e.lock.Lock()
+	e.spans = append(e.spans, spans...)
+	e.lock.Unlock()
+	return nil
+}
+
+func TestStartSpan(t *testing.T) {
+	exporter := &fakeExporter{}
+	SetExporter(exporter)
+	assert.True(t, Enabled())
+
+	parent, _ := Extract(func(key string) string {
+		if key == "traceparent" {
+			return "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+		}
+		return ""
+	})
+	span := StartSpan(parent, "test", SpanKindInternal)
+	require.NotNil(t, span)
+	span.SetAttribute("k", "v")
+	span.SetError("oops")
+	span.End()
+	span.End()
+
+	// unsampled or invalid parent
+	notSampled := parent
+	notSampled.Sampled = false
+	assert.Nil(t, StartSpan(notSampled, "test", SpanKindInternal))
+	assert.Nil(t, StartSpan(SpanContext{}, "test", SpanKindInternal))
+	// nil span is safe to use
+	var nilSpan *Span
+	nilSpan.SetAttribute("k", "v")
+	nilSpan.End()
+	assert.False(t, nilSpan.SpanContext().IsValid())
+
+	// flush the spans
+	SetExporter(nil)
+	assert.False(t, Enabled())
+
+	require.Len(t, exporter.spans, 1)
+	data := exporter.spans[0]
+	assert.Equal(t, parent.TraceID, data.TraceID)
+	assert.Equal(t, parent.SpanID, data.ParentSpanID)
+	assert.Equal(t, "test", data.Name)
+	assert.Equal(t, "v", data.Attributes["k"])
+	assert.Equal(t, "oops", data.Error)
+	assert.False(t, data.EndTime.Before(data.StartTime))
+}
+
+func TestRootSampler(t *testing.T) {
+	defer SetRootSampleRatio(0)
+
+	_, ok := NewRootSpanContext()
+	assert.False(t, ok)
+
+	SetRootSampleRatio(2)
+	assert.Equal(t, 1.0, RootSampleRatio())
+	root, ok := NewRootSpanContext()
+	require.True(t, ok)
+	assert.True(t, root.TraceID.IsValid())
+	assert.False(t, root.SpanID.IsValid())
+	span := StartSpan(root, "test", SpanKindInternal)
+	require.NotNil(t, span)
+	assert.Equal(t, root.TraceID, span.SpanContext().TraceID)
+	assert.False(t, span.data.ParentSpanID.IsValid())
+	assert.True(t, span.SpanContext().IsValid())
+
+	SetRootSampleRatio(-1)
+	assert.Equal(t, 0.0, RootSampleRatio())
+
+	tests := []struct {
+		sampler string
+		arg     string
+		ratio   float64
+	}{
+		{sampler: "parentbased_always_on", ratio: 1},
+		{sampler: "parentbased_always_off", ratio: 0},
+		{sampler: "parentbased_traceidratio", arg: "0.25", ratio: 0.25},
+		{sampler: "parentbased_traceidratio", ratio: 1},
+		{sampler: "parentbased_traceidratio", arg: "x", ratio: 1},
+		{sampler: "always_on", ratio: 0},
+		{ratio: 0},
+	}
+	for _, tt := range tests {
+		SetRootSampleRatio(0)
+		setRootSamplerFromEnv(tt.sampler, tt.arg)
+		assert.Equal(t, tt.ratio, RootSampleRatio(), tt.sampler+" "+tt.arg)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	exporter := &fakeExporter{}
+	SetExporter(exporter)
+
+	parent := StartSpan(SpanContext{
+		TraceID: TraceID{1},
+		SpanID:  SpanID{1},
+		Sampled: true,
+	}, "parent", SpanKindInternal)
+	ctx := ContextWithSpan(context.Background(), parent)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	client := &http.Client{Transport: NewTransport(nil)}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header.Get("traceparent"), "the original request should not be modified")
+	parent.End()
+
+	// no span in the context
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, traceparent)
+
+	SetExporter(nil)
+	require.Len(t, exporter.spans, 2)
+	span := exporter.spans[0]
+	assert.Equal(t, "HTTP GET", span.Name)
+	assert.Equal(t, SpanKindClient, span.Kind)
+	assert.Equal(t, parent.SpanContext().SpanID, span.ParentSpanID)
+	assert.Equal(t, "503", span.Attributes["http.status_code"])
+	assert.NotEmpty(t, span.Error)
+}
+
+func TestOTLPHTTPExporter(t *testing.T) {
+	var body map[string]any
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPHTTPExporter(OTLPHTTPExporterOptions{
+		Endpoint: server.URL,
+		Headers:  parseOTLPHeaders("Authorization=Bearer token, x-tenant = a"),
+	})
+	span := &SpanData{
+		TraceID:    TraceID{1},
+		SpanID:     SpanID{2},
+		Name:       "test",
+		Kind:       SpanKindInternal,
+		Attributes: map[string]string{"k": "v"},
+		Error:      "oops",
+	}
+	err := exporter.ExportSpans(context.Background(), []*SpanData{span})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", auth)
+
+	rs := body["resourceSpans"].([]any)[0].(map[string]any)
+	attrs := rs["resource"].(map[string]any)["attributes"].([]any)
+	assert.Equal(t, "htnn", attrs[0].(map[string]any)["value"].(map[string]any)["stringValue"])
+	s := rs["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)[0].(map[string]any)
+	assert.Equal(t, "01000000000000000000000000000000", s["traceId"])
+	assert.Equal(t, "0200000000000000", s["spanId"])
+	assert.Nil(t, s["parentSpanId"])
+	assert.Equal(t, float64(otlpStatusCodeError), s["status"].(map[string]any)["code"])
+
+	server.Close()
+	err = exporter.ExportSpans(context.Background(), []*SpanData{span})
+	assert.Error(t, err)
+}
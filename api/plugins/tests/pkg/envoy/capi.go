@@ -18,6 +18,7 @@ package envoy
 
 import (
 	"bytes"
+	"context"
 	"log"
 	"net/http"
 	"net/url"
@@ -591,6 +592,10 @@ func (i *filterCallbackHandler) PluginState() api.PluginState {
 	return i.pluginState
 }
 
+func (i *filterCallbackHandler) Context() context.Context {
+	return context.Background()
+}
+
 func (i *filterCallbackHandler) WithLogArg(key string, value any) api.StreamFilterCallbacks {
 	return i
 }
@@ -233,10 +233,6 @@ func TestConsumerFilterNotAfterConsumerRunDecodeRequest(t *testing.T) {
 				"consumer": `{"name":"marvin"}`,
 			},
 		}),
-		NoErrorLogCheck: true,
-		ExpectLogPattern: []string{
-			`plugin beforeConsumerAndHasDecodeRequest has DecodeRequest which is not supported because the order of plugin`,
-		},
 	})
 	if err != nil {
 		t.Fatalf("failed to start data plane: %v", err)
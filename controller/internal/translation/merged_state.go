@@ -171,10 +171,17 @@ func translateFilterManagerConfigToPolicyInRDS(fmc *filtermanager.FilterManagerC
 		}
 		plugins := make([]interface{}, len(goFilterManager.Plugins))
 		for i, plugin := range goFilterManager.Plugins {
-			plugins[i] = map[string]interface{}{
+			m := map[string]interface{}{
 				"name":   plugin.Name,
 				"config": plugin.Config,
 			}
+			if plugin.Match != "" {
+				m["match"] = plugin.Match
+			}
+			if plugin.Timeout != "" {
+				m["timeout"] = plugin.Timeout
+			}
+			plugins[i] = m
 		}
 		v["plugins"] = plugins
 
@@ -291,10 +298,17 @@ func translateFilterManagerConfigToPolicyInLDS(fmc *filtermanager.FilterManagerC
 		}
 		plugins := make([]interface{}, len(goFilterManager.Plugins))
 		for i, plugin := range goFilterManager.Plugins {
-			plugins[i] = map[string]interface{}{
+			m := map[string]interface{}{
 				"name":   plugin.Name,
 				"config": plugin.Config,
 			}
+			if plugin.Match != "" {
+				m["match"] = plugin.Match
+			}
+			if plugin.Timeout != "" {
+				m["timeout"] = plugin.Timeout
+			}
+			plugins[i] = m
 		}
 		cfg["plugins"] = plugins
 		config[model.CategoryECDSGolang] = cfg
@@ -362,8 +376,10 @@ func translateFilterPolicyToFilterManagerConfig(policy *mosniov1.FilterPolicy) *
 	}
 	for name, filter := range policy.Spec.Filters {
 		fmc.Plugins = append(fmc.Plugins, &fmModel.FilterConfig{
-			Name:   name,
-			Config: filter.Config.Raw,
+			Name:    name,
+			Config:  filter.Config.Raw,
+			Match:   filter.Match,
+			Timeout: filter.Timeout,
 		})
 	}
 
@@ -15,5 +15,6 @@
 package plugins
 
 import (
+	_ "mosn.io/htnn/plugins/dynamicconfigs/casbinpolicy"
 	_ "mosn.io/htnn/plugins/dynamicconfigs/demo"
 )
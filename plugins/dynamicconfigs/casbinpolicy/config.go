@@ -0,0 +1,86 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casbinpolicy
+
+import (
+	"slices"
+	"sync"
+
+	"mosn.io/htnn/api/pkg/dynamicconfig"
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/dynamicconfigs/casbinpolicy"
+)
+
+type policy struct {
+	lines   []string
+	version uint64
+}
+
+var (
+	lock     sync.RWMutex
+	policies = map[string]*policy{}
+	// version is shared by all policies, so a policy which is removed and then added back
+	// still gets a new version.
+	version uint64
+)
+
+func init() {
+	dynamicconfig.RegisterDynamicConfigHandler(casbinpolicy.Name, &handler{})
+}
+
+type handler struct {
+	casbinpolicy.Provider
+}
+
+func (h *handler) OnUpdate(config any) error {
+	c := config.(*casbinpolicy.Config)
+	api.LogInfof("casbin policies updated, %d policies", len(c.Policies))
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	for name, p := range policies {
+		if _, ok := c.Policies[name]; !ok && p.lines != nil {
+			version++
+			policies[name] = &policy{version: version}
+		}
+	}
+	for name, p := range c.Policies {
+		lines := p.GetLines()
+		if lines == nil {
+			lines = []string{}
+		}
+		old, ok := policies[name]
+		if ok && old.lines != nil && slices.Equal(old.lines, lines) {
+			continue
+		}
+		version++
+		policies[name] = &policy{lines: lines, version: version}
+	}
+	return nil
+}
+
+// GetPolicy returns the policy lines with the given name and its version. The version changes
+// once the policy is changed. A nil slice is returned if the policy doesn't exist.
+func GetPolicy(name string) ([]string, uint64) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	p, ok := policies[name]
+	if !ok {
+		return nil, 0
+	}
+	return p.lines, p.version
+}
@@ -0,0 +1,70 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casbinpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "mosn.io/htnn/api/plugins/tests/pkg/envoy" // mock log
+	"mosn.io/htnn/types/dynamicconfigs/casbinpolicy"
+)
+
+func TestOnUpdate(t *testing.T) {
+	h := &handler{}
+	lines, ver := GetPolicy("a")
+	assert.Nil(t, lines)
+	assert.Equal(t, uint64(0), ver)
+
+	require.NoError(t, h.OnUpdate(&casbinpolicy.Config{
+		Policies: map[string]*casbinpolicy.Policy{
+			"a": {Lines: []string{"p, alice, /, GET"}},
+			"b": {},
+		},
+	}))
+	lines, verA := GetPolicy("a")
+	assert.Equal(t, []string{"p, alice, /, GET"}, lines)
+	assert.NotEqual(t, uint64(0), verA)
+	lines, verB := GetPolicy("b")
+	assert.Equal(t, []string{}, lines)
+	assert.NotEqual(t, uint64(0), verB)
+
+	// unchanged policy keeps its version
+	require.NoError(t, h.OnUpdate(&casbinpolicy.Config{
+		Policies: map[string]*casbinpolicy.Policy{
+			"a": {Lines: []string{"p, alice, /, GET"}},
+		},
+	}))
+	_, ver = GetPolicy("a")
+	assert.Equal(t, verA, ver)
+	lines, ver = GetPolicy("b")
+	assert.Nil(t, lines)
+	assert.Greater(t, ver, verB)
+
+	// removed policy is added back
+	require.NoError(t, h.OnUpdate(&casbinpolicy.Config{
+		Policies: map[string]*casbinpolicy.Policy{
+			"a": {Lines: []string{"p, bob, /, GET"}},
+			"b": {},
+		},
+	}))
+	lines, ver = GetPolicy("a")
+	assert.Equal(t, []string{"p, bob, /, GET"}, lines)
+	assert.Greater(t, ver, verA)
+	lines, _ = GetPolicy("b")
+	assert.Equal(t, []string{}, lines)
+}
@@ -0,0 +1,219 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// Message is a message in the request, with the content flattened to text.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// protocol knows where the content, the model and the token usage are in the messages of an
+// LLM API.
+type protocol interface {
+	requestMessages(req gjson.Result) []Message
+	requestModel(req gjson.Result) string
+	responseContent(resp gjson.Result) string
+	responseModel(resp gjson.Result) string
+	// responseUsage returns the completion tokens and the prompt tokens
+	responseUsage(resp gjson.Result) (int64, int64)
+	streamResponseContent(event gjson.Result) string
+	streamResponseModel(event gjson.Result) string
+}
+
+var protocols = map[v1.LLMProtocol]protocol{
+	v1.LLMProtocol_OPENAI_CHAT_COMPLETIONS: &openaiChatCompletions{},
+	v1.LLMProtocol_OPENAI_RESPONSES:        &openaiResponses{},
+	v1.LLMProtocol_ANTHROPIC_MESSAGES:      &anthropicMessages{},
+	v1.LLMProtocol_GEMINI_GENERATE_CONTENT: &geminiGenerateContent{},
+	v1.LLMProtocol_OLLAMA:                  &ollama{},
+}
+
+// Extractor extracts the content, the model and the token usage from the messages of an LLM API
+// with the built-in preset of the protocol. It's shared by the plugins which handle LLM traffic.
+// An Extractor keeps the data of a request, so it's not safe for concurrent use. Create one per
+// request.
+type Extractor struct {
+	protocol protocol
+	// The body of Ollama's streaming response is newline-delimited JSON, so it may contain
+	// multiple objects.
+	parsed []gjson.Result
+}
+
+func NewExtractor(p v1.LLMProtocol) (*Extractor, error) {
+	proto, ok := protocols[p]
+	if !ok {
+		return nil, fmt.Errorf("unknown LLM protocol: %s", p)
+	}
+	return &Extractor{
+		protocol: proto,
+	}, nil
+}
+
+// SetData parses the raw data and prepares the internal state for subsequent extraction calls.
+// Both JSON and newline-delimited JSON are accepted.
+func (e *Extractor) SetData(data []byte) error {
+	e.parsed = e.parsed[:0]
+	if len(data) == 0 {
+		return errors.New("invalid json data")
+	}
+	if gjson.ValidBytes(data) {
+		e.parsed = append(e.parsed, gjson.ParseBytes(data))
+		return nil
+	}
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if !gjson.ValidBytes(line) {
+			e.parsed = e.parsed[:0]
+			return errors.New("invalid json data")
+		}
+		e.parsed = append(e.parsed, gjson.ParseBytes(line))
+	}
+	if len(e.parsed) == 0 {
+		return errors.New("invalid json data")
+	}
+	return nil
+}
+
+// Data returns the parsed data. When the data contains multiple JSON objects, the first one is
+// returned.
+func (e *Extractor) Data() gjson.Result {
+	if len(e.parsed) == 0 {
+		return gjson.Result{}
+	}
+	return e.parsed[0]
+}
+
+// RequestMessages returns the messages in the request, including the system prompt.
+func (e *Extractor) RequestMessages() []Message {
+	return e.protocol.requestMessages(e.Data())
+}
+
+// RequestContent returns the text of all the messages in the request.
+func (e *Extractor) RequestContent() string {
+	messages := e.RequestMessages()
+	contents := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m.Content != "" {
+			contents = append(contents, m.Content)
+		}
+	}
+	return strings.Join(contents, "\n")
+}
+
+func (e *Extractor) RequestModel() string {
+	return e.protocol.requestModel(e.Data())
+}
+
+// ResponseContent returns the text generated in the response. For newline-delimited JSON, the
+// text of each object is concatenated.
+func (e *Extractor) ResponseContent() string {
+	if len(e.parsed) > 1 {
+		var sb strings.Builder
+		for _, res := range e.parsed {
+			sb.WriteString(e.protocol.streamResponseContent(res))
+		}
+		return sb.String()
+	}
+	return e.protocol.responseContent(e.Data())
+}
+
+func (e *Extractor) ResponseModel() string {
+	return e.protocol.responseModel(e.Data())
+}
+
+// ResponseUsage returns the completion tokens and the prompt tokens reported in the response.
+// For newline-delimited JSON, the usage is reported in the last object.
+func (e *Extractor) ResponseUsage() (int64, int64) {
+	if len(e.parsed) == 0 {
+		return 0, 0
+	}
+	return e.protocol.responseUsage(e.parsed[len(e.parsed)-1])
+}
+
+// StreamResponseContent returns the text generated in the event of the streaming response.
+func (e *Extractor) StreamResponseContent() string {
+	return e.protocol.streamResponseContent(e.Data())
+}
+
+// StreamResponseModel returns the model in the event of the streaming response. Some protocols
+// only report the model in the first event.
+func (e *Extractor) StreamResponseModel() string {
+	return e.protocol.streamResponseModel(e.Data())
+}
+
+// text returns the text of the content, which is either a string or a list of parts.
+func text(content gjson.Result) string {
+	if content.Type == gjson.String {
+		return content.String()
+	}
+	if !content.IsArray() {
+		return ""
+	}
+
+	var texts []string
+	content.ForEach(func(_, part gjson.Result) bool {
+		t := part.Get("text")
+		if t.Type == gjson.String {
+			texts = append(texts, t.String())
+		}
+		return true
+	})
+	return strings.Join(texts, "\n")
+}
+
+// messages returns the messages with role and content, skipping the items like tool calls.
+func messages(items gjson.Result, defaultRole string) []Message {
+	var res []Message
+	items.ForEach(func(_, item gjson.Result) bool {
+		role := item.Get("role").String()
+		if role == "" {
+			role = defaultRole
+		}
+		if role == "" {
+			return true
+		}
+
+		content := item.Get("content")
+		if !content.Exists() {
+			content = item.Get("parts")
+		}
+		res = append(res, Message{Role: role, Content: text(content)})
+		return true
+	})
+	return res
+}
+
+func prependSystem(msgs []Message, system string) []Message {
+	if system == "" {
+		return msgs
+	}
+	return append([]Message{{Role: "system", Content: system}}, msgs...)
+}
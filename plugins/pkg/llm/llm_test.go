@@ -0,0 +1,222 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestNewExtractor(t *testing.T) {
+	_, err := NewExtractor(v1.LLMProtocol(100))
+	assert.ErrorContains(t, err, "unknown LLM protocol")
+}
+
+func TestSetData(t *testing.T) {
+	e, err := NewExtractor(v1.LLMProtocol_OLLAMA)
+	require.NoError(t, err)
+
+	assert.Error(t, e.SetData(nil))
+	assert.Error(t, e.SetData([]byte("{")))
+	assert.Error(t, e.SetData([]byte("{}\n{")))
+	assert.Error(t, e.SetData([]byte("\n\n")))
+	assert.False(t, e.Data().Exists())
+
+	require.NoError(t, e.SetData([]byte(`{"model":"llama3"}`)))
+	assert.Equal(t, "llama3", e.Data().Get("model").String())
+	require.NoError(t, e.SetData([]byte("{\"model\":\"a\"}\n{\"model\":\"b\"}\n")))
+	assert.Equal(t, "a", e.Data().Get("model").String())
+}
+
+func TestExtractor(t *testing.T) {
+	type streamEvent struct {
+		data    string
+		content string
+		model   string
+	}
+
+	tests := []struct {
+		protocol v1.LLMProtocol
+
+		request  string
+		messages []Message
+		model    string
+
+		response         string
+		responseContent  string
+		responseModel    string
+		completionTokens int64
+		promptTokens     int64
+
+		events []streamEvent
+	}{
+		{
+			protocol: v1.LLMProtocol_OPENAI_CHAT_COMPLETIONS,
+			request: `{"model":"gpt-4o","messages":[
+				{"role":"system","content":"You are helpful."},
+				{"role":"user","content":[{"type":"text","text":"Hi"},{"type":"image_url","image_url":{"url":"x"}},{"type":"text","text":"there"}]},
+				{"role":"assistant","content":null,"tool_calls":[{"id":"1"}]}
+			]}`,
+			messages: []Message{
+				{Role: "system", Content: "You are helpful."},
+				{Role: "user", Content: "Hi\nthere"},
+				{Role: "assistant", Content: ""},
+			},
+			model: "gpt-4o",
+			response: `{"model":"gpt-4o-2024-08-06","choices":[{"index":0,"message":{"role":"assistant","content":"Hello!"}}],
+				"usage":{"prompt_tokens":12,"completion_tokens":3}}`,
+			responseContent:  "Hello!",
+			responseModel:    "gpt-4o-2024-08-06",
+			completionTokens: 3,
+			promptTokens:     12,
+			events: []streamEvent{
+				{data: `{"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{"role":"assistant","content":""}}]}`, model: "gpt-4o-2024-08-06"},
+				{data: `{"model":"gpt-4o-2024-08-06","choices":[{"index":0,"delta":{"content":"Hel"}}]}`, content: "Hel", model: "gpt-4o-2024-08-06"},
+				{data: `{"model":"gpt-4o-2024-08-06","choices":[],"usage":{"completion_tokens":3}}`, model: "gpt-4o-2024-08-06"},
+			},
+		},
+		{
+			protocol: v1.LLMProtocol_OPENAI_RESPONSES,
+			request: `{"model":"gpt-4.1","instructions":"Be brief.","input":[
+				{"role":"user","content":[{"type":"input_text","text":"Hi"}]},
+				{"type":"function_call_output","call_id":"1","output":"{}"},
+				{"type":"message","role":"assistant","content":"Hello"}
+			]}`,
+			messages: []Message{
+				{Role: "system", Content: "Be brief."},
+				{Role: "user", Content: "Hi"},
+				{Role: "assistant", Content: "Hello"},
+			},
+			model: "gpt-4.1",
+			response: `{"model":"gpt-4.1-2025-04-14","output":[
+				{"type":"reasoning","summary":[]},
+				{"type":"message","role":"assistant","content":[{"type":"output_text","text":"Hello!"},{"type":"refusal","refusal":"no"}]}
+			],"usage":{"input_tokens":10,"output_tokens":2}}`,
+			responseContent:  "Hello!",
+			responseModel:    "gpt-4.1-2025-04-14",
+			completionTokens: 2,
+			promptTokens:     10,
+			events: []streamEvent{
+				{data: `{"type":"response.created","response":{"model":"gpt-4.1-2025-04-14"}}`, model: "gpt-4.1-2025-04-14"},
+				{data: `{"type":"response.output_text.delta","item_id":"msg_1","delta":"Hel"}`, content: "Hel"},
+				{data: `{"type":"response.output_text.done","item_id":"msg_1","text":"Hello!"}`},
+			},
+		},
+		{
+			protocol: v1.LLMProtocol_ANTHROPIC_MESSAGES,
+			request: `{"model":"claude-sonnet-4-5","system":[{"type":"text","text":"Be brief."}],"messages":[
+				{"role":"user","content":"Hi"},
+				{"role":"assistant","content":[{"type":"text","text":"Let me check."},{"type":"tool_use","id":"1","name":"f","input":{}}]}
+			]}`,
+			messages: []Message{
+				{Role: "system", Content: "Be brief."},
+				{Role: "user", Content: "Hi"},
+				{Role: "assistant", Content: "Let me check."},
+			},
+			model: "claude-sonnet-4-5",
+			response: `{"type":"message","model":"claude-sonnet-4-5-20250929","content":[{"type":"text","text":"Hello!"}],
+				"usage":{"input_tokens":9,"output_tokens":4}}`,
+			responseContent:  "Hello!",
+			responseModel:    "claude-sonnet-4-5-20250929",
+			completionTokens: 4,
+			promptTokens:     9,
+			events: []streamEvent{
+				{data: `{"type":"message_start","message":{"model":"claude-sonnet-4-5-20250929","content":[]}}`, model: "claude-sonnet-4-5-20250929"},
+				{data: `{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hel"}}`, content: "Hel"},
+				{data: `{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{"}}`},
+				{data: `{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":4}}`},
+			},
+		},
+		{
+			protocol: v1.LLMProtocol_GEMINI_GENERATE_CONTENT,
+			request: `{"systemInstruction":{"parts":[{"text":"Be brief."}]},"contents":[
+				{"parts":[{"text":"Hi"}]},
+				{"role":"model","parts":[{"text":"Hello"}]}
+			]}`,
+			messages: []Message{
+				{Role: "system", Content: "Be brief."},
+				{Role: "user", Content: "Hi"},
+				{Role: "model", Content: "Hello"},
+			},
+			response: `{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello!"}]}}],"modelVersion":"gemini-2.0-flash",
+				"usageMetadata":{"promptTokenCount":5,"candidatesTokenCount":2,"totalTokenCount":7}}`,
+			responseContent:  "Hello!",
+			responseModel:    "gemini-2.0-flash",
+			completionTokens: 2,
+			promptTokens:     5,
+			events: []streamEvent{
+				{data: `{"candidates":[{"content":{"role":"model","parts":[{"text":"Hel"}]}}],"modelVersion":"gemini-2.0-flash"}`,
+					content: "Hel", model: "gemini-2.0-flash"},
+			},
+		},
+		{
+			protocol: v1.LLMProtocol_OLLAMA,
+			request:  `{"model":"llama3","messages":[{"role":"user","content":"Hi"}]}`,
+			messages: []Message{
+				{Role: "user", Content: "Hi"},
+			},
+			model: "llama3",
+			// the streaming response is newline-delimited JSON
+			response: "{\"model\":\"llama3\",\"message\":{\"role\":\"assistant\",\"content\":\"Hel\"},\"done\":false}\n" +
+				"{\"model\":\"llama3\",\"message\":{\"role\":\"assistant\",\"content\":\"lo!\"},\"done\":false}\n" +
+				"{\"model\":\"llama3\",\"message\":{\"role\":\"assistant\",\"content\":\"\"},\"done\":true,\"prompt_eval_count\":26,\"eval_count\":3}\n",
+			responseContent:  "Hello!",
+			responseModel:    "llama3",
+			completionTokens: 3,
+			promptTokens:     26,
+			events: []streamEvent{
+				{data: `{"model":"llama3","message":{"role":"assistant","content":"Hel"},"done":false}`, content: "Hel", model: "llama3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.protocol.String(), func(t *testing.T) {
+			e, err := NewExtractor(tt.protocol)
+			require.NoError(t, err)
+
+			require.NoError(t, e.SetData([]byte(tt.request)))
+			assert.Equal(t, tt.messages, e.RequestMessages())
+			assert.Equal(t, tt.model, e.RequestModel())
+
+			require.NoError(t, e.SetData([]byte(tt.response)))
+			assert.Equal(t, tt.responseContent, e.ResponseContent())
+			assert.Equal(t, tt.responseModel, e.ResponseModel())
+			completionTokens, promptTokens := e.ResponseUsage()
+			assert.Equal(t, tt.completionTokens, completionTokens)
+			assert.Equal(t, tt.promptTokens, promptTokens)
+
+			for i, ev := range tt.events {
+				require.NoError(t, e.SetData([]byte(ev.data)))
+				assert.Equal(t, ev.content, e.StreamResponseContent(), "event %d", i)
+				assert.Equal(t, ev.model, e.StreamResponseModel(), "event %d", i)
+			}
+		})
+	}
+}
+
+func TestRequestContent(t *testing.T) {
+	e, err := NewExtractor(v1.LLMProtocol_OLLAMA)
+	require.NoError(t, err)
+
+	require.NoError(t, e.SetData([]byte(`{"model":"llama3","system":"Be brief.","prompt":"Why is the sky blue?"}`)))
+	assert.Equal(t, "Be brief.\nWhy is the sky blue?", e.RequestContent())
+	require.NoError(t, e.SetData([]byte(`{"model":"llama3","response":"Because","done":true}`)))
+	assert.Equal(t, "Because", e.ResponseContent())
+}
@@ -0,0 +1,229 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+type openaiChatCompletions struct{}
+
+func (p *openaiChatCompletions) requestMessages(req gjson.Result) []Message {
+	return messages(req.Get("messages"), "")
+}
+
+func (p *openaiChatCompletions) requestModel(req gjson.Result) string {
+	return req.Get("model").String()
+}
+
+func (p *openaiChatCompletions) responseContent(resp gjson.Result) string {
+	var texts []string
+	resp.Get("choices").ForEach(func(_, choice gjson.Result) bool {
+		if t := text(choice.Get("message.content")); t != "" {
+			texts = append(texts, t)
+		}
+		return true
+	})
+	return strings.Join(texts, "\n")
+}
+
+func (p *openaiChatCompletions) responseModel(resp gjson.Result) string {
+	return resp.Get("model").String()
+}
+
+func (p *openaiChatCompletions) responseUsage(resp gjson.Result) (int64, int64) {
+	return resp.Get("usage.completion_tokens").Int(), resp.Get("usage.prompt_tokens").Int()
+}
+
+func (p *openaiChatCompletions) streamResponseContent(event gjson.Result) string {
+	var sb strings.Builder
+	event.Get("choices").ForEach(func(_, choice gjson.Result) bool {
+		sb.WriteString(choice.Get("delta.content").String())
+		return true
+	})
+	return sb.String()
+}
+
+func (p *openaiChatCompletions) streamResponseModel(event gjson.Result) string {
+	return event.Get("model").String()
+}
+
+type openaiResponses struct{}
+
+func (p *openaiResponses) requestMessages(req gjson.Result) []Message {
+	var msgs []Message
+	input := req.Get("input")
+	if input.Type == gjson.String {
+		msgs = []Message{{Role: "user", Content: input.String()}}
+	} else {
+		msgs = messages(input, "")
+	}
+	return prependSystem(msgs, req.Get("instructions").String())
+}
+
+func (p *openaiResponses) requestModel(req gjson.Result) string {
+	return req.Get("model").String()
+}
+
+func (p *openaiResponses) responseContent(resp gjson.Result) string {
+	var texts []string
+	resp.Get("output").ForEach(func(_, item gjson.Result) bool {
+		if item.Get("type").String() != "message" {
+			return true
+		}
+		item.Get("content").ForEach(func(_, part gjson.Result) bool {
+			if part.Get("type").String() == "output_text" {
+				texts = append(texts, part.Get("text").String())
+			}
+			return true
+		})
+		return true
+	})
+	return strings.Join(texts, "\n")
+}
+
+func (p *openaiResponses) responseModel(resp gjson.Result) string {
+	return resp.Get("model").String()
+}
+
+func (p *openaiResponses) responseUsage(resp gjson.Result) (int64, int64) {
+	return resp.Get("usage.output_tokens").Int(), resp.Get("usage.input_tokens").Int()
+}
+
+func (p *openaiResponses) streamResponseContent(event gjson.Result) string {
+	if event.Get("type").String() != "response.output_text.delta" {
+		return ""
+	}
+	return event.Get("delta").String()
+}
+
+func (p *openaiResponses) streamResponseModel(event gjson.Result) string {
+	// events like response.created and response.completed carry the response
+	return event.Get("response.model").String()
+}
+
+type anthropicMessages struct{}
+
+func (p *anthropicMessages) requestMessages(req gjson.Result) []Message {
+	return prependSystem(messages(req.Get("messages"), ""), text(req.Get("system")))
+}
+
+func (p *anthropicMessages) requestModel(req gjson.Result) string {
+	return req.Get("model").String()
+}
+
+func (p *anthropicMessages) responseContent(resp gjson.Result) string {
+	return text(resp.Get("content"))
+}
+
+func (p *anthropicMessages) responseModel(resp gjson.Result) string {
+	return resp.Get("model").String()
+}
+
+func (p *anthropicMessages) responseUsage(resp gjson.Result) (int64, int64) {
+	return resp.Get("usage.output_tokens").Int(), resp.Get("usage.input_tokens").Int()
+}
+
+func (p *anthropicMessages) streamResponseContent(event gjson.Result) string {
+	if event.Get("type").String() != "content_block_delta" || event.Get("delta.type").String() != "text_delta" {
+		return ""
+	}
+	return event.Get("delta.text").String()
+}
+
+func (p *anthropicMessages) streamResponseModel(event gjson.Result) string {
+	// only the message_start event carries the model
+	return event.Get("message.model").String()
+}
+
+type geminiGenerateContent struct{}
+
+func (p *geminiGenerateContent) requestMessages(req gjson.Result) []Message {
+	return prependSystem(messages(req.Get("contents"), "user"), text(req.Get("systemInstruction.parts")))
+}
+
+// requestModel returns empty as the model is in the path of the request.
+func (p *geminiGenerateContent) requestModel(req gjson.Result) string {
+	return ""
+}
+
+func (p *geminiGenerateContent) responseContent(resp gjson.Result) string {
+	var texts []string
+	resp.Get("candidates").ForEach(func(_, candidate gjson.Result) bool {
+		if t := text(candidate.Get("content.parts")); t != "" {
+			texts = append(texts, t)
+		}
+		return true
+	})
+	return strings.Join(texts, "\n")
+}
+
+func (p *geminiGenerateContent) responseModel(resp gjson.Result) string {
+	return resp.Get("modelVersion").String()
+}
+
+func (p *geminiGenerateContent) responseUsage(resp gjson.Result) (int64, int64) {
+	return resp.Get("usageMetadata.candidatesTokenCount").Int(), resp.Get("usageMetadata.promptTokenCount").Int()
+}
+
+func (p *geminiGenerateContent) streamResponseContent(event gjson.Result) string {
+	// each event is a partial response
+	return p.responseContent(event)
+}
+
+func (p *geminiGenerateContent) streamResponseModel(event gjson.Result) string {
+	return p.responseModel(event)
+}
+
+type ollama struct{}
+
+func (p *ollama) requestMessages(req gjson.Result) []Message {
+	if prompt := req.Get("prompt"); prompt.Exists() {
+		// /api/generate
+		return prependSystem([]Message{{Role: "user", Content: prompt.String()}}, req.Get("system").String())
+	}
+	return messages(req.Get("messages"), "")
+}
+
+func (p *ollama) requestModel(req gjson.Result) string {
+	return req.Get("model").String()
+}
+
+func (p *ollama) responseContent(resp gjson.Result) string {
+	if content := resp.Get("message.content"); content.Exists() {
+		return content.String()
+	}
+	// /api/generate
+	return resp.Get("response").String()
+}
+
+func (p *ollama) responseModel(resp gjson.Result) string {
+	return resp.Get("model").String()
+}
+
+func (p *ollama) responseUsage(resp gjson.Result) (int64, int64) {
+	return resp.Get("eval_count").Int(), resp.Get("prompt_eval_count").Int()
+}
+
+func (p *ollama) streamResponseContent(event gjson.Result) string {
+	// each line of the streaming response is a partial response
+	return p.responseContent(event)
+}
+
+func (p *ollama) streamResponseModel(event gjson.Result) string {
+	return p.responseModel(event)
+}
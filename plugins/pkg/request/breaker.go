@@ -0,0 +1,139 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+const (
+	defaultConsecutiveFailures = 5
+	defaultOpenDuration        = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned when the request is rejected by the circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerPolicy configures the per-host circuit breaker. When the host fails continuously,
+// the circuit is opened and the requests to this host fail fast. After OpenDuration, a request is
+// allowed to probe the host. The circuit is closed if the probe succeeds.
+type CircuitBreakerPolicy struct {
+	// ConsecutiveFailures opens the circuit. Default to 5.
+	ConsecutiveFailures int
+	// OpenDuration is the time the circuit keeps open before a probe. Default to 30s.
+	OpenDuration time.Duration
+}
+
+// NewCircuitBreakerPolicy converts the circuit breaker of the plugin. It returns nil if the configuration is nil.
+func NewCircuitBreakerPolicy(conf *v1.HTTPCircuitBreaker) *CircuitBreakerPolicy {
+	if conf == nil {
+		return nil
+	}
+	return &CircuitBreakerPolicy{
+		ConsecutiveFailures: int(conf.ConsecutiveFailures),
+		OpenDuration:        conf.OpenDuration.AsDuration(),
+	}
+}
+
+func (p *CircuitBreakerPolicy) setDefault() {
+	if p.ConsecutiveFailures == 0 {
+		p.ConsecutiveFailures = defaultConsecutiveFailures
+	}
+	if p.OpenDuration == 0 {
+		p.OpenDuration = defaultOpenDuration
+	}
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+type breaker struct {
+	lock      sync.Mutex
+	policy    CircuitBreakerPolicy
+	state     breakerState
+	failures  int
+	openUntil time.Time
+}
+
+// The breakers are kept across the clients, so the state is not lost when the plugin configuration
+// is changed.
+var breakers sync.Map
+
+func getBreaker(name string, host string, policy *CircuitBreakerPolicy) *breaker {
+	key := name + "|" + host
+	if v, ok := breakers.Load(key); ok {
+		b := v.(*breaker)
+		b.lock.Lock()
+		b.policy = *policy
+		b.lock.Unlock()
+		return b
+	}
+	v, _ := breakers.LoadOrStore(key, &breaker{policy: *policy})
+	return v.(*breaker)
+}
+
+func (b *breaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Now().Before(b.openUntil) {
+			return false
+		}
+		// only one request is allowed to probe the host
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *breaker) report(success bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if success {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.policy.ConsecutiveFailures {
+		b.state = breakerOpen
+		b.openUntil = time.Now().Add(b.policy.OpenDuration)
+	}
+}
+
+// release gives up the probe without the result, so that the next request can probe the host
+func (b *breaker) release() {
+	b.lock.Lock()
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+	}
+	b.lock.Unlock()
+}
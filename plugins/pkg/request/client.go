@@ -0,0 +1,213 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"mosn.io/htnn/api/pkg/tracing"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+const (
+	defaultMaxIdleConnsPerHost = 16
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// ClientOptions configures the HTTP client used by the plugins to call the outbound services.
+type ClientOptions struct {
+	// Name identifies the client in the metrics, for example, the plugin name. Required.
+	Name string
+	// Timeout limits the whole call, including the retries. Zero means no timeout.
+	Timeout time.Duration
+	// MaxIdleConnsPerHost controls the idle connections kept per host. Default to 16.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is the time an idle connection is kept. Default to 90s.
+	IdleConnTimeout time.Duration
+	// TLS configures the TLS to the outbound service. The system CA is used when it's nil.
+	TLS *TLSOptions
+	// Retry is disabled when it's nil.
+	Retry *RetryPolicy
+	// CircuitBreaker is disabled when it's nil.
+	CircuitBreaker *CircuitBreakerPolicy
+}
+
+// TLSOptions configures the TLS. The certificates and the key are in PEM format.
+type TLSOptions struct {
+	// CACert is used to verify the server. The system CA is used when it's empty.
+	CACert []byte
+	// ClientCert and ClientKey are sent to the server for mTLS.
+	ClientCert []byte
+	ClientKey  []byte
+	// ServerName overrides the SNI and the name to verify.
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// NewTLSOptions converts the TLS configuration of the plugin. It returns nil if the configuration is nil.
+func NewTLSOptions(conf *v1.HTTPClientTLS) *TLSOptions {
+	if conf == nil {
+		return nil
+	}
+	return &TLSOptions{
+		CACert:             []byte(conf.CaCert),
+		ClientCert:         []byte(conf.ClientCert),
+		ClientKey:          []byte(conf.ClientKey),
+		ServerName:         conf.ServerName,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+}
+
+func (o *TLSOptions) build() (*tls.Config, error) {
+	conf := &tls.Config{
+		ServerName:         o.ServerName,
+		InsecureSkipVerify: o.InsecureSkipVerify, // #nosec G402 -- configured by the user
+	}
+	if len(o.CACert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(o.CACert) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+		conf.RootCAs = pool
+	}
+	if len(o.ClientCert) > 0 || len(o.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(o.ClientCert, o.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
+
+// sharedTransport is the transport shared by the clients with the same connection configuration
+type sharedTransport struct {
+	*http.Transport
+
+	refs int
+}
+
+var (
+	transportsLock sync.Mutex
+	transports     = map[string]*sharedTransport{}
+)
+
+// transportKey returns the key of the transport. The clients with the same connection
+// configuration share the same transport, so the connections can be reused after the
+// plugin configuration is changed.
+func transportKey(opts *ClientOptions) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d|%d", opts.MaxIdleConnsPerHost, opts.IdleConnTimeout)
+	if t := opts.TLS; t != nil {
+		fmt.Fprintf(h, "|%q|%q|%q|%q|%t", t.CACert, t.ClientCert, t.ClientKey, t.ServerName, t.InsecureSkipVerify)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getTransport returns the shared transport and increases its reference count. The transport
+// should be released via releaseTransport when the client is not used.
+func getTransport(key string, opts *ClientOptions) (*http.Transport, error) {
+	transportsLock.Lock()
+	defer transportsLock.Unlock()
+
+	if t, ok := transports[key]; ok {
+		t.refs++
+		return t.Transport, nil
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	t.IdleConnTimeout = opts.IdleConnTimeout
+	if opts.TLS != nil {
+		conf, err := opts.TLS.build()
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig = conf
+	}
+	transports[key] = &sharedTransport{Transport: t, refs: 1}
+	return t, nil
+}
+
+// releaseTransport decreases the reference count of the transport. The transport is removed and
+// its idle connections are closed when it's not referenced.
+func releaseTransport(key string) {
+	transportsLock.Lock()
+	defer transportsLock.Unlock()
+
+	t, ok := transports[key]
+	if !ok {
+		return
+	}
+	t.refs--
+	if t.refs <= 0 {
+		delete(transports, key)
+		t.CloseIdleConnections()
+	}
+}
+
+// NewClient returns a http.Client which supports connection pooling, retry, circuit breaking,
+// tracing and metrics. The client can be shared among goroutines. The connections are closed
+// after the client is garbage collected.
+func NewClient(opts ClientOptions) (*http.Client, error) {
+	if opts.Name == "" {
+		return nil, errors.New("name of the client is required")
+	}
+	if opts.MaxIdleConnsPerHost == 0 {
+		opts.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout == 0 {
+		opts.IdleConnTimeout = defaultIdleConnTimeout
+	}
+
+	key := transportKey(&opts)
+	base, err := getTransport(key, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &roundTripper{
+		name:         opts.Name,
+		base:         base,
+		retry:        opts.Retry,
+		breaker:      opts.CircuitBreaker,
+		transportKey: key,
+	}
+	if rt.retry != nil {
+		rt.retry.setDefault()
+	}
+	if rt.breaker != nil {
+		rt.breaker.setDefault()
+	}
+	// The clients are kept in the plugin configurations, so the transport is released when the
+	// configuration is garbage collected.
+	runtime.SetFinalizer(rt, func(rt *roundTripper) {
+		releaseTransport(rt.transportKey)
+	})
+
+	return &http.Client{
+		Timeout:   opts.Timeout,
+		Transport: tracing.NewTransport(rt),
+	}, nil
+}
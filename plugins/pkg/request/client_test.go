@@ -0,0 +1,243 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(ClientOptions{})
+	assert.ErrorContains(t, err, "name of the client is required")
+
+	_, err = NewClient(ClientOptions{Name: "test", TLS: &TLSOptions{CACert: []byte("bad")}})
+	assert.ErrorContains(t, err, "failed to parse CA certificate")
+	_, err = NewClient(ClientOptions{Name: "test", TLS: &TLSOptions{ClientCert: []byte("bad")}})
+	assert.ErrorContains(t, err, "failed to load client certificate")
+
+	client, err := NewClient(ClientOptions{Name: "test", Timeout: time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, client.Timeout)
+
+	// the transport is shared by the clients with the same connection configuration
+	opts := ClientOptions{MaxIdleConnsPerHost: defaultMaxIdleConnsPerHost, IdleConnTimeout: defaultIdleConnTimeout}
+	key := transportKey(&opts)
+	t1, _ := getTransport(key, &opts)
+	t2, _ := getTransport(key, &opts)
+	assert.Same(t, t1, t2)
+	opts.TLS = &TLSOptions{InsecureSkipVerify: true}
+	t3, _ := getTransport(transportKey(&opts), &opts)
+	assert.NotSame(t, t1, t3)
+	assert.True(t, t3.TLSClientConfig.InsecureSkipVerify)
+	releaseTransport(transportKey(&opts))
+	releaseTransport(key)
+	releaseTransport(key)
+}
+
+func TestTransportReleased(t *testing.T) {
+	opts := ClientOptions{
+		Name:                "release",
+		MaxIdleConnsPerHost: defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     defaultIdleConnTimeout,
+		TLS:                 &TLSOptions{ServerName: "release.test"},
+	}
+	client, err := NewClient(opts)
+	require.NoError(t, err)
+	key := transportKey(&opts)
+
+	transportsLock.Lock()
+	assert.Equal(t, 1, transports[key].refs)
+	transportsLock.Unlock()
+
+	client = nil
+	assert.Nil(t, client)
+	require.Eventually(t, func() bool {
+		runtime.GC()
+		transportsLock.Lock()
+		defer transportsLock.Unlock()
+		_, ok := transports[key]
+		return !ok
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	client, err := NewClient(ClientOptions{Name: "tls"})
+	require.NoError(t, err)
+	_, err = client.Get(srv.URL)
+	assert.Error(t, err)
+
+	client, err = NewClient(ClientOptions{Name: "tls", TLS: &TLSOptions{InsecureSkipVerify: true}})
+	require.NoError(t, err)
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+func TestRetry(t *testing.T) {
+	var count atomic.Int32
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		if count.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(ClientOptions{
+		Name: "retry",
+		Retry: &RetryPolicy{
+			MaxRetries:   2,
+			BaseInterval: time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+	resp, err := client.Post(srv.URL, "text/plain", bytes.NewReader([]byte("body")))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{"body", "body", "body"}, bodies)
+
+	retries := api.DefineCounter("htnn.http_client.retries").WithTags(api.MetricTag{Key: "client", Value: "retry"})
+	assert.Equal(t, uint64(2), retries.Get())
+	tags := []api.MetricTag{{Key: "client", Value: "retry"}, {Key: "result", Value: "503"}}
+	assert.Equal(t, uint64(2), api.DefineCounter("htnn.http_client.requests").WithTags(tags...).Get())
+
+	// exceed max retries
+	count.Store(0)
+	resp, err = client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	count.Store(-10)
+	resp, err = client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(-7), count.Load())
+
+	// the body can't be rewound
+	count.Store(0)
+	resp, err = client.Post(srv.URL, "text/plain", io.NopCloser(bytes.NewReader([]byte("body"))))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseInterval: 10 * time.Millisecond}
+	p.setDefault()
+	for i := 1; i < 100; i++ {
+		d := p.backoff(i)
+		assert.Greater(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+		if i == 1 {
+			assert.LessOrEqual(t, d, 10*time.Millisecond)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var count atomic.Int32
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(ClientOptions{
+		Name: "breaker",
+		CircuitBreaker: &CircuitBreakerPolicy{
+			ConsecutiveFailures: 2,
+			OpenDuration:        50 * time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	_, err = client.Get(srv.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), count.Load())
+	tags := []api.MetricTag{{Key: "client", Value: "breaker"}, {Key: "result", Value: "circuit_open"}}
+	assert.Equal(t, uint64(1), api.DefineCounter("htnn.http_client.requests").WithTags(tags...).Get())
+
+	// the probe fails
+	time.Sleep(60 * time.Millisecond)
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	_, err = client.Get(srv.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	// the probe succeeds
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(6), count.Load())
+}
+
+func TestNewOptionsFromConfig(t *testing.T) {
+	assert.Nil(t, NewTLSOptions(nil))
+	o := NewTLSOptions(&v1.HTTPClientTLS{CaCert: "ca", ServerName: "example.com", InsecureSkipVerify: true})
+	assert.Equal(t, []byte("ca"), o.CACert)
+	assert.Empty(t, o.ClientCert)
+	assert.Equal(t, "example.com", o.ServerName)
+	assert.True(t, o.InsecureSkipVerify)
+
+	assert.Nil(t, NewRetryPolicy(nil))
+	p := NewRetryPolicy(&v1.HTTPRetryPolicy{BaseInterval: durationpb.New(time.Second)})
+	p.setDefault()
+	assert.Equal(t, defaultMaxRetries, p.MaxRetries)
+	assert.Equal(t, time.Second, p.BaseInterval)
+	assert.Equal(t, 10*time.Second, p.MaxInterval)
+
+	assert.Nil(t, NewCircuitBreakerPolicy(nil))
+	b := NewCircuitBreakerPolicy(&v1.HTTPCircuitBreaker{ConsecutiveFailures: 3})
+	b.setDefault()
+	assert.Equal(t, 3, b.ConsecutiveFailures)
+	assert.Equal(t, defaultOpenDuration, b.OpenDuration)
+}
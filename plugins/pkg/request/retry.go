@@ -0,0 +1,110 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+// The default values are the same as Envoy's retry policy
+const (
+	defaultMaxRetries        = 1
+	defaultRetryBaseInterval = 25 * time.Millisecond
+)
+
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Default to 1.
+	MaxRetries int
+	// BaseInterval is the base of the exponential backoff. Default to 25ms.
+	BaseInterval time.Duration
+	// MaxInterval caps the backoff. Default to 10 times of the BaseInterval.
+	MaxInterval time.Duration
+	// RetryOn decides whether the result of an attempt should be retried.
+	// Default to DefaultRetryOn.
+	RetryOn func(resp *http.Response, err error) bool
+}
+
+// NewRetryPolicy converts the retry policy of the plugin. It returns nil if the policy is nil.
+func NewRetryPolicy(conf *v1.HTTPRetryPolicy) *RetryPolicy {
+	if conf == nil {
+		return nil
+	}
+	return &RetryPolicy{
+		MaxRetries:   int(conf.MaxRetries),
+		BaseInterval: conf.BaseInterval.AsDuration(),
+		MaxInterval:  conf.MaxInterval.AsDuration(),
+	}
+}
+
+func (p *RetryPolicy) setDefault() {
+	if p.MaxRetries == 0 {
+		p.MaxRetries = defaultMaxRetries
+	}
+	if p.BaseInterval == 0 {
+		p.BaseInterval = defaultRetryBaseInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = 10 * p.BaseInterval
+	}
+	if p.RetryOn == nil {
+		p.RetryOn = DefaultRetryOn
+	}
+}
+
+// DefaultRetryOn retries the connection failures and the 502, 503 and 504 responses
+func DefaultRetryOn(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the interval before the n-th retry (starts from 1). It's the exponential backoff
+// with full jitter, so that the clients won't retry at the same time.
+func (p *RetryPolicy) backoff(n int) time.Duration {
+	d := p.MaxInterval
+	if n < 32 {
+		if exp := p.BaseInterval << (n - 1); exp > 0 && exp < d {
+			d = exp
+		}
+	}
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
+// canRetry returns true if the request body can be sent again
+func canRetry(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r := *req
+	r.Body = body
+	return &r, nil
+}
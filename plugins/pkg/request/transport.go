@@ -0,0 +1,117 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+// Histograms are not supported, so we record the total latency in a counter.
+var (
+	clientRequests = api.DefineCounter("htnn.http_client.requests")
+	clientRetries  = api.DefineCounter("htnn.http_client.retries")
+	clientLatency  = api.DefineCounter("htnn.http_client.latency_us")
+)
+
+const (
+	resultError       = "error"
+	resultCircuitOpen = "circuit_open"
+)
+
+func recordRequest(name string, result string) {
+	clientRequests.WithTags(
+		api.MetricTag{Key: "client", Value: name},
+		api.MetricTag{Key: "result", Value: result},
+	).Increment(1)
+}
+
+type roundTripper struct {
+	name    string
+	base    http.RoundTripper
+	retry   *RetryPolicy
+	breaker *CircuitBreakerPolicy
+
+	// transportKey is used to release the shared transport
+	transportKey string
+}
+
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var b *breaker
+	if rt.breaker != nil {
+		b = getBreaker(rt.name, req.URL.Host, rt.breaker)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if b != nil && !b.allow() {
+			recordRequest(rt.name, resultCircuitOpen)
+			return nil, ErrCircuitOpen
+		}
+
+		r := req
+		if attempt > 0 {
+			var err error
+			r, err = rewindRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := rt.do(r)
+		if b != nil {
+			if req.Context().Err() != nil {
+				// The cancellation from the caller is not the fault of the host
+				b.release()
+			} else {
+				b.report(err == nil && resp.StatusCode < 500)
+			}
+		}
+
+		if rt.retry == nil || attempt >= rt.retry.MaxRetries || !canRetry(req) || !rt.retry.RetryOn(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			// drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(rt.retry.backoff(attempt + 1))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		clientRetries.WithTags(api.MetricTag{Key: "client", Value: rt.name}).Increment(1)
+	}
+}
+
+func (rt *roundTripper) do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.base.RoundTrip(req)
+	clientLatency.WithTags(api.MetricTag{Key: "client", Value: rt.name}).Increment(time.Since(start).Microseconds())
+
+	if err != nil {
+		recordRequest(rt.name, resultError)
+	} else {
+		recordRequest(rt.name, strconv.Itoa(resp.StatusCode))
+	}
+	return resp, err
+}
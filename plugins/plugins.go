@@ -16,6 +16,7 @@ package plugins
 
 import (
 	_ "mosn.io/htnn/plugins/plugins/aicontentsecurity"
+	_ "mosn.io/htnn/plugins/plugins/basicauth"
 	_ "mosn.io/htnn/plugins/plugins/casbin"
 	_ "mosn.io/htnn/plugins/plugins/celscript"
 	_ "mosn.io/htnn/plugins/plugins/consumerrestriction"
@@ -23,10 +24,13 @@ import (
 	_ "mosn.io/htnn/plugins/plugins/demo"
 	_ "mosn.io/htnn/plugins/plugins/extauth"
 	_ "mosn.io/htnn/plugins/plugins/hmacauth"
+	_ "mosn.io/htnn/plugins/plugins/jwtauth"
 	_ "mosn.io/htnn/plugins/plugins/keyauth"
 	_ "mosn.io/htnn/plugins/plugins/limitcountredis"
 	_ "mosn.io/htnn/plugins/plugins/limitreq"
 	_ "mosn.io/htnn/plugins/plugins/limittoken"
+	_ "mosn.io/htnn/plugins/plugins/mtlsauth"
+	_ "mosn.io/htnn/plugins/plugins/multiauth"
 	_ "mosn.io/htnn/plugins/plugins/oidc"
 	_ "mosn.io/htnn/plugins/plugins/opa"
 	_ "mosn.io/htnn/plugins/plugins/sentinel"
@@ -45,7 +45,7 @@ type config struct {
 	aicontentsecurity.CustomConfig
 
 	moderator         moderation.Moderator
-	extractor         extractor.Extractor
+	newExtractor      func() extractor.Extractor
 	moderationTimeout time.Duration
 }
 
@@ -65,12 +65,17 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	conf.moderator = moderator
 
 	extractorTypeName := reflect.TypeOf(conf.ExtractorConfig).String()
-	newExtractor, err := extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
+	_, err = extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
 	if err != nil {
 		api.LogErrorf("failed to create newExtractor for provider type '%s': %v", extractorTypeName, err)
 		return err
 	}
-	conf.extractor = newExtractor
+	// The Extractor keeps the data of the request, so each request has its own Extractor
+	conf.newExtractor = func() extractor.Extractor {
+		// the config is already verified
+		e, _ := extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
+		return e
+	}
 
 	return nil
 }
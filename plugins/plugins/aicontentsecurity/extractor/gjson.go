@@ -103,11 +103,22 @@ func (g *GjsonContentExtractor) StreamResponseContent() string {
 }
 
 func (g *GjsonContentExtractor) IDsFromRequestHeaders(headers api.RequestHeaderMap, idMap map[string]string) {
-	if g.config == nil || g.config.HeaderFields == nil {
+	if g.config == nil {
 		return
 	}
+	idsFromHeaders(g.config.HeaderFields, headers, idMap)
+}
 
-	for _, field := range g.config.HeaderFields {
+func (g *GjsonContentExtractor) IDsFromRequestData(idMap map[string]string) {
+	if g.config == nil {
+		return
+	}
+	idsFromData(g.config.BodyFields, g.parsedData, idMap)
+}
+
+// idsFromHeaders extracts the IDs from the headers according to the field mappings.
+func idsFromHeaders(fields []*aicontentsecurity.FieldMapping, headers api.RequestHeaderMap, idMap map[string]string) {
+	for _, field := range fields {
 		if field.SourceField == "" || field.TargetField == "" {
 			continue
 		}
@@ -119,17 +130,18 @@ func (g *GjsonContentExtractor) IDsFromRequestHeaders(headers api.RequestHeaderM
 	}
 }
 
-func (g *GjsonContentExtractor) IDsFromRequestData(idMap map[string]string) {
-	if !g.parsedData.Exists() || g.config == nil || g.config.BodyFields == nil {
+// idsFromData extracts the IDs from the parsed body with the GJSON paths in the field mappings.
+func idsFromData(fields []*aicontentsecurity.FieldMapping, data gjson.Result, idMap map[string]string) {
+	if !data.Exists() {
 		return
 	}
 
-	for _, field := range g.config.BodyFields {
+	for _, field := range fields {
 		if field.GetSourceField() == "" || field.GetTargetField() == "" {
 			continue
 		}
 
-		result := g.parsedData.Get(field.GetSourceField())
+		result := data.Get(field.GetSourceField())
 		if result.Exists() {
 			idMap[field.TargetField] = result.String()
 		}
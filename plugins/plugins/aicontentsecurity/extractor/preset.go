@@ -0,0 +1,82 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"errors"
+	"reflect"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/llm"
+	"mosn.io/htnn/types/plugins/aicontentsecurity"
+)
+
+func init() {
+	var cfg *aicontentsecurity.Config_PresetConfig
+	typeName := reflect.TypeOf(cfg).String()
+	Register(typeName, NewPreset)
+}
+
+// PresetContentExtractor extracts the content with the built-in preset of the LLM API.
+type PresetContentExtractor struct {
+	config    *aicontentsecurity.PresetConfig
+	extractor *llm.Extractor
+}
+
+func NewPreset(config interface{}) (Extractor, error) {
+	wrapper, ok := config.(*aicontentsecurity.Config_PresetConfig)
+	if !ok {
+		return nil, errors.New("invalid config type for PresetContentExtractor")
+	}
+
+	configWrapper := wrapper.PresetConfig
+	if configWrapper == nil {
+		return nil, errors.New("PresetContentExtractor config is empty inside the wrapper")
+	}
+
+	extractor, err := llm.NewExtractor(configWrapper.Protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PresetContentExtractor{
+		config:    configWrapper,
+		extractor: extractor,
+	}, nil
+}
+
+func (p *PresetContentExtractor) SetData(data []byte) error {
+	return p.extractor.SetData(data)
+}
+
+func (p *PresetContentExtractor) RequestContent() string {
+	return p.extractor.RequestContent()
+}
+
+func (p *PresetContentExtractor) ResponseContent() string {
+	return p.extractor.ResponseContent()
+}
+
+func (p *PresetContentExtractor) StreamResponseContent() string {
+	return p.extractor.StreamResponseContent()
+}
+
+func (p *PresetContentExtractor) IDsFromRequestHeaders(headers api.RequestHeaderMap, idMap map[string]string) {
+	idsFromHeaders(p.config.HeaderFields, headers, idMap)
+}
+
+func (p *PresetContentExtractor) IDsFromRequestData(idMap map[string]string) {
+	idsFromData(p.config.BodyFields, p.extractor.Data(), idMap)
+}
@@ -0,0 +1,69 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/aicontentsecurity"
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+)
+
+func TestNewPreset(t *testing.T) {
+	_, err := NewPreset(&aicontentsecurity.Config_GjsonConfig{})
+	assert.ErrorContains(t, err, "invalid config type")
+	_, err = NewPreset(&aicontentsecurity.Config_PresetConfig{})
+	assert.ErrorContains(t, err, "config is empty")
+	_, err = NewPreset(&aicontentsecurity.Config_PresetConfig{
+		PresetConfig: &aicontentsecurity.PresetConfig{Protocol: v1.LLMProtocol(100)},
+	})
+	assert.ErrorContains(t, err, "unknown LLM protocol")
+}
+
+func TestPresetContentExtractor(t *testing.T) {
+	ex, err := NewPreset(&aicontentsecurity.Config_PresetConfig{
+		PresetConfig: &aicontentsecurity.PresetConfig{
+			Protocol: v1.LLMProtocol_ANTHROPIC_MESSAGES,
+			HeaderFields: []*aicontentsecurity.FieldMapping{
+				{SourceField: "X-Session-ID", TargetField: "session_id"},
+			},
+			BodyFields: []*aicontentsecurity.FieldMapping{
+				{SourceField: "metadata.user_id", TargetField: "user_id"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ex.SetData([]byte(`{"model":"claude-sonnet-4-5","system":"Be brief.",
+		"messages":[{"role":"user","content":[{"type":"text","text":"Hi"}]}],"metadata":{"user_id":"u1"}}`)))
+	assert.Equal(t, "Be brief.\nHi", ex.RequestContent())
+
+	idMap := map[string]string{}
+	ex.IDsFromRequestData(idMap)
+	headers := envoy.NewRequestHeaderMap(http.Header{})
+	headers.Set("X-Session-ID", "s1")
+	ex.IDsFromRequestHeaders(headers, idMap)
+	assert.Equal(t, map[string]string{"user_id": "u1", "session_id": "s1"}, idMap)
+
+	require.NoError(t, ex.SetData([]byte(`{"content":[{"type":"text","text":"Hello!"}]}`)))
+	assert.Equal(t, "Hello!", ex.ResponseContent())
+	require.NoError(t, ex.SetData([]byte(`{"type":"content_block_delta","delta":{"type":"text_delta","text":"Hel"}}`)))
+	assert.Equal(t, "Hel", ex.StreamResponseContent())
+}
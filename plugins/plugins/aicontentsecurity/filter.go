@@ -25,6 +25,7 @@ import (
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/plugins/plugins/aicontentsecurity/contentbuffer"
+	"mosn.io/htnn/plugins/plugins/aicontentsecurity/extractor"
 	"mosn.io/htnn/plugins/plugins/aicontentsecurity/moderation"
 	"mosn.io/htnn/plugins/plugins/aicontentsecurity/sseparser"
 )
@@ -34,6 +35,7 @@ func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &filter{
 		callbacks: callbacks,
 		config:    config,
+		extractor: config.newExtractor(),
 		idMap:     make(map[string]string),
 		contentBuf: contentbuffer.NewContentBuffer(contentbuffer.WithMaxChars(int(config.ModerationCharLimit)),
 			contentbuffer.WithOverlapCharNum(int(config.ModerationChunkOverlapLength))),
@@ -45,6 +47,7 @@ type filter struct {
 
 	callbacks      api.FilterCallbackHandler
 	config         *config
+	extractor      extractor.Extractor
 	idMap          map[string]string
 	streamResponse bool
 
@@ -68,7 +71,7 @@ func isStream(headers api.HeaderMap) bool {
 }
 
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	f.config.extractor.IDsFromRequestHeaders(headers, f.idMap)
+	f.extractor.IDsFromRequestHeaders(headers, f.idMap)
 	return api.Continue
 }
 
@@ -121,8 +124,8 @@ func (f *filter) sseDataHandler(data api.BufferInstance, endStream bool) api.Res
 		}
 
 		newAddedEventFlag = false
-		_ = f.config.extractor.SetData([]byte(event.Data))
-		eventContent := f.config.extractor.StreamResponseContent()
+		_ = f.extractor.SetData([]byte(event.Data))
+		eventContent := f.extractor.StreamResponseContent()
 		// Always write to ensure the counter is correct.
 		f.contentBuf.Write([]byte(eventContent))
 	}
@@ -242,7 +245,7 @@ func (f *filter) performModeration(ctx context.Context, buffers []string, isEnco
 }
 
 func (f *filter) dataHandler(data api.BufferInstance, endStream bool, isEncode bool) api.ResultAction {
-	extractor := f.config.extractor
+	extractor := f.extractor
 	var err error
 	actionType := "DecodeData"
 	if isEncode {
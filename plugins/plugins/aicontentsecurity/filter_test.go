@@ -153,10 +153,12 @@ func getCfg(opts testConfig) *config {
 		conf.moderator = &mockModerator{}
 	}
 
-	if opts.extractor != nil {
-		conf.extractor = opts.extractor
-	} else {
-		conf.extractor = &mockExtractor{}
+	ext := opts.extractor
+	if ext == nil {
+		ext = &mockExtractor{}
+	}
+	conf.newExtractor = func() extractor.Extractor {
+		return ext
 	}
 
 	// Set default moderation timeout
@@ -31,6 +31,7 @@ import (
 	"strings"
 	"time"
 
+	"mosn.io/htnn/plugins/pkg/request"
 	"mosn.io/htnn/plugins/plugins/aicontentsecurity/moderation"
 	"mosn.io/htnn/types/plugins/aicontentsecurity"
 )
@@ -127,12 +128,21 @@ func New(config interface{}) (moderation.Moderator, error) {
 		m.maxRiskLevel = level
 	}
 
+	timeout := 2 * time.Second
 	if conf.GetTimeout() != "" {
-		duration, _ := time.ParseDuration(conf.GetTimeout())
-		m.httpClient = &http.Client{Timeout: duration}
-	} else {
-		m.httpClient = &http.Client{Timeout: 2 * time.Second}
+		timeout, _ = time.ParseDuration(conf.GetTimeout())
 	}
+	client, err := request.NewClient(request.ClientOptions{
+		Name:           aicontentsecurity.Name,
+		Timeout:        timeout,
+		TLS:            request.NewTLSOptions(conf.GetTls()),
+		Retry:          request.NewRetryPolicy(conf.GetRetry()),
+		CircuitBreaker: request.NewCircuitBreakerPolicy(conf.GetCircuitBreaker()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.httpClient = client
 
 	if region := conf.GetRegion(); region != "" {
 		m.endpoint = fmt.Sprintf(endpointTemplate, region)
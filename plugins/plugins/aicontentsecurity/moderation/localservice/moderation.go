@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"mosn.io/htnn/plugins/pkg/request"
 	"mosn.io/htnn/plugins/plugins/aicontentsecurity/moderation"
 	"mosn.io/htnn/types/plugins/aicontentsecurity"
 )
@@ -60,10 +61,16 @@ func New(config interface{}) (moderation.Moderator, error) {
 
 	}
 
+	client, err := request.NewClient(request.ClientOptions{
+		Name:    aicontentsecurity.Name,
+		Timeout: timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &LocalService{
-		client: &http.Client{
-			Timeout: timeout,
-		},
+		client:             client,
 		baseURL:            conf.BaseUrl,
 		unhealthyWords:     conf.UnhealthyWords,
 		customErrorMessage: conf.CustomErrorMessage,
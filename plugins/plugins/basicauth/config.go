@@ -0,0 +1,52 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func init() {
+	plugins.RegisterPlugin(basicauth.Name, &plugin{})
+}
+
+type plugin struct {
+	basicauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type config struct {
+	basicauth.Config
+
+	challenge string
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	realm := conf.Realm
+	if realm == "" {
+		realm = "htnn"
+	}
+	conf.challenge = `Basic realm="` + realm + `"`
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func TestConsumerConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "bcrypt",
+			input: `{"username":"a", "passwordHash":"$2a$10$INPY9s5UXSsQkQ9lwO2mWekbofTIOzoD0Rm15swqn0AFktA6RqEUO"}`,
+		},
+		{
+			name:  "argon2",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+		},
+		{
+			name:  "argon2 without iterations",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=65536,t=0,p=4$c2FsdHNhbHQ$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+			err:   "invalid argon2 iterations 0",
+		},
+		{
+			name:  "argon2 without parallelism",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=65536,t=3,p=0$c2FsdHNhbHQ$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+			err:   "invalid argon2 parallelism 0",
+		},
+		{
+			name:  "argon2 with too many threads",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=65536,t=3,p=256$c2FsdHNhbHQ$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+			err:   "invalid argon2 parallelism 256",
+		},
+		{
+			name:  "argon2 with too much memory",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=4194304,t=3,p=4$c2FsdHNhbHQ$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+			err:   "invalid argon2 memory 4194304",
+		},
+		{
+			name:  "argon2 with empty digest",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=16,t=1,p=1$c2FsdHNhbHQ$"}`,
+			err:   "invalid ConsumerConfig.PasswordHash",
+		},
+		{
+			name:  "argon2 with short digest",
+			input: `{"username":"a", "passwordHash":"$argon2id$v=19$m=16,t=1,p=1$c2FsdHNhbHQ$aGFzaA"}`,
+			err:   "invalid argon2 hash: should be at least 16 bytes",
+		},
+		{
+			name:  "argon2 with short salt",
+			input: `{"username":"a", "passwordHash":"$argon2i$v=19$m=16,t=1,p=1$c2FsdA$UvSV/uEb7ZVrm8yhnNhxCwHUXnL8nBXGmW6KWY7sOIU"}`,
+			err:   "invalid argon2 salt: should be at least 8 bytes",
+		},
+		{
+			name:  "plain password",
+			input: `{"username":"a", "passwordHash":"password"}`,
+			err:   "invalid ConsumerConfig.PasswordHash",
+		},
+		{
+			name:  "invalid username",
+			input: `{"username":"a:b", "passwordHash":"$2a$10$INPY9s5UXSsQkQ9lwO2mWekbofTIOzoD0Rm15swqn0AFktA6RqEUO"}`,
+			err:   "invalid ConsumerConfig.Username",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &basicauth.CustomConsumerConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+}
+
+func (f *filter) unauthorized(msg string) api.ResultAction {
+	return &api.LocalResponse{
+		Code:   401,
+		Msg:    msg,
+		Header: http.Header{"Www-Authenticate": []string{f.config.challenge}},
+	}
+}
+
+func decodeCredential(encoded string) (username string, password string, ok bool) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	var credentials []string
+	for _, v := range headers.Values("authorization") {
+		scheme, encoded, _ := strings.Cut(v, " ")
+		// Skip the credential of other authentication schemes, like Bearer
+		if strings.EqualFold(scheme, "Basic") {
+			credentials = append(credentials, encoded)
+		}
+	}
+	if len(credentials) == 0 {
+		// Send the challenge so that the browser prompts for the credential
+		return f.unauthorized("missing credential")
+	}
+	if len(credentials) > 1 {
+		return f.unauthorized("duplicate credential found")
+	}
+
+	username, password, ok := decodeCredential(credentials[0])
+	if !ok {
+		return f.unauthorized("invalid credential")
+	}
+
+	name := basicauth.Name
+	c, ok := f.callbacks.LookupConsumer(name, username)
+	if !ok {
+		api.LogInfof("can not find consumer with username %s", username)
+		return f.unauthorized("invalid username or password")
+	}
+
+	conf := c.PluginConfig(name).(*basicauth.CustomConsumerConfig)
+	ok, err := verifyPassword(conf.PasswordHash, password)
+	if err != nil {
+		api.LogErrorf("failed to verify the password of consumer %s: %v", c.Name(), err)
+	}
+	if !ok {
+		return f.unauthorized("invalid username or password")
+	}
+
+	if f.config.HideCredentials {
+		headers.Del("authorization")
+	}
+	f.callbacks.SetConsumer(c)
+	return api.Continue
+}
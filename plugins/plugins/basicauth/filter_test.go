@@ -0,0 +1,210 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+func bcryptHash(t *testing.T, password string) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+	return string(hash)
+}
+
+func argon2Hash(password string) string {
+	salt := make([]byte, 16)
+	_, _ = rand.Read(salt)
+	hash := argon2.IDKey([]byte(password), salt, 1, 64*1024, 2, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, 64*1024, 1, 2,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash))
+}
+
+func basic(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func TestBasicAuth(t *testing.T) {
+	name := basicauth.Name
+	consumers := map[string]api.Consumer{
+		"bcrypt": consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+			name: &basicauth.CustomConsumerConfig{
+				ConsumerConfig: basicauth.ConsumerConfig{
+					Username:     "bcrypt",
+					PasswordHash: bcryptHash(t, "pass"),
+				},
+			},
+		}),
+		"argon2": consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+			name: &basicauth.CustomConsumerConfig{
+				ConsumerConfig: basicauth.ConsumerConfig{
+					Username:     "argon2",
+					PasswordHash: argon2Hash("pass"),
+				},
+			},
+		}),
+	}
+
+	tests := []struct {
+		name      string
+		conf      string
+		hdr       []string
+		status    int
+		consumer  string
+		challenge string
+		hidden    bool
+	}{
+		{
+			name:     "bcrypt",
+			hdr:      []string{basic("bcrypt", "pass")},
+			consumer: "bcrypt",
+		},
+		{
+			name:     "argon2, hide credentials",
+			conf:     `{"hideCredentials": true}`,
+			hdr:      []string{basic("argon2", "pass")},
+			consumer: "argon2",
+			hidden:   true,
+		},
+		{
+			name:      "wrong password",
+			hdr:       []string{basic("bcrypt", "wrong")},
+			status:    401,
+			challenge: `Basic realm="htnn"`,
+		},
+		{
+			name:      "wrong password, argon2",
+			conf:      `{"realm": "internal"}`,
+			hdr:       []string{basic("argon2", "wrong")},
+			status:    401,
+			challenge: `Basic realm="internal"`,
+		},
+		{
+			name:   "consumer not found",
+			hdr:    []string{basic("unknown", "pass")},
+			status: 401,
+		},
+		{
+			name:      "no credential",
+			status:    401,
+			challenge: `Basic realm="htnn"`,
+		},
+		{
+			name:      "other scheme",
+			conf:      `{"realm": "internal"}`,
+			hdr:       []string{"Bearer xxx"},
+			status:    401,
+			challenge: `Basic realm="internal"`,
+		},
+		{
+			name:   "malformed credential",
+			hdr:    []string{"Basic xxx"},
+			status: 401,
+		},
+		{
+			name:   "duplicate credential",
+			hdr:    []string{basic("bcrypt", "pass"), basic("argon2", "pass")},
+			status: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			conf := &config{}
+			if tt.conf != "" {
+				require.NoError(t, protojson.Unmarshal([]byte(tt.conf), conf))
+			}
+			require.NoError(t, conf.Init(nil))
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+				c, ok := consumers[key]
+				return c, ok
+			})
+			defer patches.Reset()
+
+			hdr := envoy.NewRequestHeaderMap(http.Header{
+				":path":         {"/"},
+				"Authorization": tt.hdr,
+			})
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+				if tt.challenge != "" {
+					assert.Equal(t, tt.challenge, r.Header.Get("WWW-Authenticate"))
+				}
+			} else {
+				assert.Equal(t, api.Continue, res)
+			}
+
+			if tt.consumer != "" {
+				assert.Equal(t, consumers[tt.consumer], cb.GetConsumer())
+				_, ok := hdr.Get("authorization")
+				assert.Equal(t, !tt.hidden, ok)
+			} else {
+				assert.Nil(t, cb.GetConsumer())
+			}
+		})
+	}
+}
+
+func TestVerifyPasswordCache(t *testing.T) {
+	hash := bcryptHash(t, "cached")
+	ok, err := verifyPassword(hash, "cached")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, verifiedCredentials.Has(credentialDigest(hash, "cached")))
+
+	ok, err = verifyPassword(hash, "wrong")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, verifiedCredentials.Has(credentialDigest(hash, "wrong")))
+
+	// the cache is keyed by the hash, so changing the password takes effect immediately
+	ok, _ = verifyPassword(bcryptHash(t, "other"), "cached")
+	assert.False(t, ok)
+
+	_, err = verifyPassword("$argon2id$v=18$m=65536,t=3,p=4$c2FsdA$aGFzaA", "cached")
+	assert.ErrorContains(t, err, "unsupported argon2 version")
+	// argon2 panics with these parameters
+	_, err = verifyPassword("$argon2id$v=19$m=65536,t=0,p=4$c2FsdA$aGFzaA", "cached")
+	assert.ErrorContains(t, err, "invalid argon2 iterations")
+	_, err = verifyPassword("$argon2id$v=19$m=65536,t=3,p=0$c2FsdA$aGFzaA", "cached")
+	assert.ErrorContains(t, err, "invalid argon2 parallelism")
+	// argon2 panics with the empty digest
+	_, err = verifyPassword("$argon2id$v=19$m=16,t=1,p=1$c2FsdHNhbHQ$", "cached")
+	assert.ErrorContains(t, err, "invalid argon2 hash: should be at least 16 bytes")
+	_, err = verifyPassword("$argon2id$v=19$m=16,t=1,p=1$$aGFzaGhhc2hoYXNoaGFzaA", "cached")
+	assert.ErrorContains(t, err, "invalid argon2 salt: should be at least 8 bytes")
+}
@@ -0,0 +1,95 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"mosn.io/htnn/types/plugins/basicauth"
+)
+
+// The password hash algorithms are slow by design. To avoid hashing the password in each request,
+// we cache the verified credentials for a while. Only the digest of the credential is stored.
+var verifiedCredentials = ttlcache.New(
+	ttlcache.WithTTL[string, struct{}](5*time.Minute),
+	ttlcache.WithCapacity[string, struct{}](10000),
+	ttlcache.WithDisableTouchOnHit[string, struct{}](),
+)
+
+func init() {
+	go verifiedCredentials.Start()
+}
+
+func credentialDigest(hash string, password string) string {
+	h := sha256.New()
+	h.Write([]byte(hash))
+	h.Write([]byte{0})
+	h.Write([]byte(password))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// verifyPassword checks if the password matches the hash
+func verifyPassword(hash string, password string) (bool, error) {
+	digest := credentialDigest(hash, password)
+	if verifiedCredentials.Has(digest) {
+		return true, nil
+	}
+
+	var ok bool
+	var err error
+	if strings.HasPrefix(hash, "$argon2") {
+		ok, err = verifyArgon2(hash, password)
+	} else {
+		err = bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			err = nil
+		} else {
+			ok = err == nil
+		}
+	}
+	if ok {
+		verifiedCredentials.Set(digest, struct{}{}, ttlcache.DefaultTTL)
+	}
+	return ok, err
+}
+
+// verifyArgon2 verifies the hash in PHC format: $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+func verifyArgon2(hash string, password string) (bool, error) {
+	// argon2 panics with the invalid parameters, so the hash is checked again in case the
+	// validation is skipped
+	h, err := basicauth.ParseArgon2Hash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	var actual []byte
+	keyLen := uint32(len(h.Digest))
+	switch h.Algorithm {
+	case "argon2id":
+		actual = argon2.IDKey([]byte(password), h.Salt, h.Time, h.Memory, h.Threads, keyLen)
+	default:
+		actual = argon2.Key([]byte(password), h.Salt, h.Time, h.Memory, h.Threads, keyLen)
+	}
+	return subtle.ConstantTimeCompare(actual, h.Digest) == 1, nil
+}
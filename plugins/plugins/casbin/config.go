@@ -16,13 +16,17 @@ package casbin
 
 import (
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	stringadapter "github.com/casbin/casbin/v2/persist/string-adapter"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/dynamicconfigs/casbinpolicy"
 	"mosn.io/htnn/plugins/pkg/file"
 	casbintype "mosn.io/htnn/types/plugins/casbin"
 )
@@ -44,7 +48,7 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	casbintype.Config
+	casbintype.CustomConfig
 
 	lock *sync.RWMutex
 
@@ -52,6 +56,8 @@ type config struct {
 	modelFile  *file.File
 	policyFile *file.File
 	updating   atomic.Bool
+	// the version of the dynamic policy used by the current enforcer
+	policyVersion atomic.Uint64
 
 	watcher *file.Watcher
 }
@@ -63,15 +69,20 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 
 	conf.modelFile = f
 
-	f = file.Stat(conf.Rule.Policy)
+	files := []*file.File{conf.modelFile}
+	if conf.Rule.GetDynamicPolicy() == "" {
+		f = file.Stat(conf.Rule.GetPolicy())
 
-	conf.policyFile = f
+		conf.policyFile = f
+		files = append(files, f)
+	}
 
-	e, err := casbin.NewEnforcer(conf.Rule.Model, conf.Rule.Policy)
+	e, version, err := conf.newEnforcer()
 	if err != nil {
 		return err
 	}
 	conf.enforcer = e
+	conf.policyVersion.Store(version)
 
 	watcher, err := file.NewWatcher()
 	if err != nil {
@@ -80,7 +91,7 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 
 	conf.watcher = watcher
 
-	err = conf.watcher.AddFiles(conf.modelFile, conf.policyFile)
+	err = conf.watcher.AddFiles(files...)
 	if err != nil {
 		return err
 	}
@@ -96,10 +107,54 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	return nil
 }
 
+// newEnforcer returns the enforcer and the version of the dynamic policy it uses.
+func (conf *config) newEnforcer() (*casbin.Enforcer, uint64, error) {
+	name := conf.Rule.GetDynamicPolicy()
+	if name == "" {
+		e, err := casbin.NewEnforcer(conf.Rule.Model, conf.Rule.GetPolicy())
+		return e, 0, err
+	}
+
+	lines, version := casbinpolicy.GetPolicy(name)
+	m, err := model.NewModelFromFile(conf.Rule.Model)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var e *casbin.Enforcer
+	if len(lines) == 0 {
+		// the policy is not pushed yet, deny all requests
+		e, err = casbin.NewEnforcer(m)
+	} else {
+		e, err = casbin.NewEnforcer(m, stringadapter.NewAdapter(strings.Join(lines, "\n")))
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return e, version, nil
+}
+
+func (conf *config) policyName() string {
+	if name := conf.Rule.GetDynamicPolicy(); name != "" {
+		return "dynamic policy " + name
+	}
+	return conf.policyFile.Name
+}
+
+// dynamicPolicyChanged reports whether the dynamic policy is changed since the enforcer is created.
+func (conf *config) dynamicPolicyChanged() bool {
+	name := conf.Rule.GetDynamicPolicy()
+	if name == "" {
+		return false
+	}
+	_, version := casbinpolicy.GetPolicy(name)
+	return version != conf.policyVersion.Load()
+}
+
 func (conf *config) reloadEnforcer() {
-	if !conf.updating.Load() {
-		conf.updating.Store(true)
-		api.LogWarnf("policy %s or model %s changed, reload enforcer", conf.policyFile.Name, conf.modelFile.Name)
+	// it can be triggered by the requests concurrently
+	if conf.updating.CompareAndSwap(false, true) {
+		api.LogWarnf("policy %s or model %s changed, reload enforcer", conf.policyName(), conf.modelFile.Name)
 
 		go func() {
 			defer func() {
@@ -108,14 +163,15 @@ func (conf *config) reloadEnforcer() {
 				}
 				conf.updating.Store(false)
 			}()
-			e, err := casbin.NewEnforcer(conf.Rule.Model, conf.Rule.Policy)
+			e, version, err := conf.newEnforcer()
 			if err != nil {
 				api.LogErrorf("failed to update Enforcer: %v", err)
 			} else {
 				conf.lock.Lock()
 				conf.enforcer = e
 				conf.lock.Unlock()
-				api.LogWarnf("policy %s or model %s changed, enforcer reloaded", conf.policyFile.Name, conf.modelFile.Name)
+				conf.policyVersion.Store(version)
+				api.LogWarnf("policy %s or model %s changed, enforcer reloaded", conf.policyName(), conf.modelFile.Name)
 			}
 		}()
 	}
@@ -46,6 +46,90 @@ func TestBadConfig(t *testing.T) {
 			}`,
 			err: "Policy: value length must be at least 1 runes",
 		},
+		{
+			name: "no policy",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf"
+				},
+				"token": {
+					"source": "HEADER",
+					"name": "role"
+				}
+			}`,
+			err: "either policy or dynamic_policy is required",
+		},
+		{
+			name: "empty dynamic policy",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf",
+					"dynamicPolicy": ""
+				},
+				"token": {
+					"source": "CONSUMER"
+				}
+			}`,
+			err: "DynamicPolicy: value length must be at least 1 runes",
+		},
+		{
+			name: "header name is required",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf",
+					"policy": "./config/policy.csv"
+				},
+				"token": {
+					"source": "HEADER"
+				}
+			}`,
+			err: "Name: value is required when the source is HEADER",
+		},
+		{
+			name: "unsupported token source",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf",
+					"policy": "./config/policy.csv"
+				},
+				"token": {
+					"source": "HOST"
+				}
+			}`,
+			err: "only HEADER and CONSUMER are supported",
+		},
+		{
+			name: "unsupported domain source",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf",
+					"policy": "./config/policy.csv"
+				},
+				"token": {
+					"source": "CONSUMER"
+				},
+				"domain": {
+					"source": "CONSUMER"
+				}
+			}`,
+			err: "only HEADER and HOST are supported",
+		},
+		{
+			name: "domain header name is required",
+			input: `{
+				"rule": {
+					"model": "./config/model.conf",
+					"policy": "./config/policy.csv"
+				},
+				"token": {
+					"source": "CONSUMER"
+				},
+				"domain": {
+					"source": "HEADER"
+				}
+			}`,
+			err: "Name: value is required when the source is HEADER",
+		},
 	}
 
 	for _, tt := range tests {
@@ -15,7 +15,10 @@
 package casbin
 
 import (
+	"net"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	casbintype "mosn.io/htnn/types/plugins/casbin"
 )
 
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
@@ -32,13 +35,53 @@ type filter struct {
 	config    *config
 }
 
+func hostWithoutPort(headers api.RequestHeaderMap) string {
+	host := headers.Host()
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
+
+func (f *filter) getSubject(headers api.RequestHeaderMap) string {
+	token := f.config.Token
+	if token.Source == casbintype.Config_CONSUMER {
+		consumer := f.callbacks.GetConsumer()
+		if consumer == nil {
+			return ""
+		}
+		return consumer.Name()
+	}
+	role, _ := headers.Get(token.Name) // role can be ""
+	return role
+}
+
+func (f *filter) getDomain(headers api.RequestHeaderMap) string {
+	domain := f.config.Domain
+	if domain.Source == casbintype.Config_HOST {
+		return hostWithoutPort(headers)
+	}
+	dom, _ := headers.Get(domain.Name)
+	return dom
+}
+
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
 	conf := f.config
-	role, _ := headers.Get(conf.Token.Name) // role can be ""
+	if conf.dynamicPolicyChanged() {
+		// the request is still handled by the old enforcer until the new one is ready
+		conf.reloadEnforcer()
+	}
+
+	role := f.getSubject(headers)
 	url := headers.URL()
+	args := []interface{}{role}
+	if conf.Domain != nil {
+		args = append(args, f.getDomain(headers))
+	}
+	args = append(args, url.Path, headers.Method())
 
 	conf.lock.RLock()
-	ok, err := f.config.enforcer.Enforce(role, url.Path, headers.Method())
+	ok, err := f.config.enforcer.Enforce(args...)
 	conf.lock.RUnlock()
 
 	if !ok {
@@ -18,14 +18,31 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/plugins/dynamicconfigs/casbinpolicy"
 	"mosn.io/htnn/types/plugins/casbin"
 )
 
+type fakeConsumer struct {
+	name string
+}
+
+func (c *fakeConsumer) Name() string {
+	return c.name
+}
+
+func (c *fakeConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
 func TestCasbin(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -64,13 +81,17 @@ func TestCasbin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			cb := envoy.NewFilterCallbackHandler()
 			c := &config{
-				Config: casbin.Config{
-					Rule: &casbin.Config_Rule{
-						Model:  "./testdata/model.conf",
-						Policy: "./testdata/policy.csv",
-					},
-					Token: &casbin.Config_Token{
-						Name: "user",
+				CustomConfig: casbin.CustomConfig{
+					Config: casbin.Config{
+						Rule: &casbin.Config_Rule{
+							Model: "./testdata/model.conf",
+							PolicySource: &casbin.Config_Rule_Policy{
+								Policy: "./testdata/policy.csv",
+							},
+						},
+						Token: &casbin.Config_Token{
+							Name: "user",
+						},
 					},
 				},
 			}
@@ -96,3 +117,161 @@ func TestCasbin(t *testing.T) {
 		})
 	}
 }
+
+func TestCasbinWithDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     string
+		consumer string
+		header   http.Header
+		status   int
+	}{
+		{
+			name: "domain from header",
+			conf: `{
+				"token": {"source": "HEADER", "name": "user"},
+				"domain": {"source": "HEADER", "name": "tenant"}
+			}`,
+			header: http.Header{"User": []string{"alice"}, "Tenant": []string{"tenant1"}},
+		},
+		{
+			name: "domain from header, other tenant",
+			conf: `{
+				"token": {"source": "HEADER", "name": "user"},
+				"domain": {"source": "HEADER", "name": "tenant"}
+			}`,
+			header: http.Header{"User": []string{"alice"}, "Tenant": []string{"tenant2"}},
+			status: 403,
+		},
+		{
+			name: "domain from host",
+			conf: `{
+				"token": {"source": "HEADER", "name": "user"},
+				"domain": {"source": "HOST"}
+			}`,
+			header: http.Header{"User": []string{"bob"}, ":authority": []string{"tenant2:8080"}},
+		},
+		{
+			name: "subject from consumer",
+			conf: `{
+				"token": {"source": "CONSUMER"},
+				"domain": {"source": "HOST"}
+			}`,
+			consumer: "alice",
+			header:   http.Header{":authority": []string{"tenant1"}},
+		},
+		{
+			name: "subject from consumer, other tenant",
+			conf: `{
+				"token": {"source": "CONSUMER"},
+				"domain": {"source": "HOST"}
+			}`,
+			consumer: "alice",
+			header:   http.Header{":authority": []string{"tenant2"}},
+			status:   403,
+		},
+		{
+			name: "no consumer",
+			conf: `{
+				"token": {"source": "CONSUMER"},
+				"domain": {"source": "HOST"}
+			}`,
+			header: http.Header{":authority": []string{"tenant1"}},
+			status: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			if tt.consumer != "" {
+				cb.SetConsumer(&fakeConsumer{name: tt.consumer})
+			}
+			c := &config{}
+			require.NoError(t, protojson.Unmarshal([]byte(tt.conf), c))
+			c.Rule = &casbin.Config_Rule{
+				Model: "./testdata/rbac_with_domains_model.conf",
+				PolicySource: &casbin.Config_Rule_Policy{
+					Policy: "./testdata/rbac_with_domains_policy.csv",
+				},
+			}
+			require.NoError(t, c.Validate())
+			require.NoError(t, c.Init(nil))
+			f := factory(c, cb)
+			tt.header.Set(":path", "/")
+			hdr := envoy.NewRequestHeaderMap(tt.header)
+
+			lr, ok := f.DecodeHeaders(hdr, true).(*api.LocalResponse)
+			if !ok {
+				assert.Equal(t, tt.status, 0)
+			} else {
+				assert.Equal(t, tt.status, lr.Code)
+			}
+		})
+	}
+}
+
+func TestCasbinDynamicPolicy(t *testing.T) {
+	var lock sync.Mutex
+	var lines []string
+	var version uint64
+	patches := gomonkey.ApplyFunc(casbinpolicy.GetPolicy, func(name string) ([]string, uint64) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, "rbac", name)
+		return lines, version
+	})
+	defer patches.Reset()
+	setPolicy := func(l []string) {
+		lock.Lock()
+		lines = l
+		version++
+		lock.Unlock()
+	}
+
+	cb := envoy.NewFilterCallbackHandler()
+	c := &config{
+		CustomConfig: casbin.CustomConfig{
+			Config: casbin.Config{
+				Rule: &casbin.Config_Rule{
+					Model: "./testdata/model.conf",
+					PolicySource: &casbin.Config_Rule_DynamicPolicy{
+						DynamicPolicy: "rbac",
+					},
+				},
+				Token: &casbin.Config_Token{
+					Name: "user",
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Init(nil))
+	f := factory(c, cb)
+	decode := func() int {
+		hdr := envoy.NewRequestHeaderMap(http.Header{"User": []string{"alice"}, ":path": []string{"/"}})
+		lr, ok := f.DecodeHeaders(hdr, true).(*api.LocalResponse)
+		if !ok {
+			return 200
+		}
+		return lr.Code
+	}
+
+	// deny all before the policy is pushed
+	assert.Equal(t, 403, decode())
+
+	setPolicy([]string{"p, admin, *, *", "g, alice, admin"})
+	assert.Eventually(t, func() bool {
+		return decode() == 200
+	}, 1*time.Second, 10*time.Millisecond)
+
+	setPolicy([]string{"p, admin, *, *", "g, bob, admin"})
+	assert.Eventually(t, func() bool {
+		return decode() == 403
+	}, 1*time.Second, 10*time.Millisecond)
+
+	setPolicy(nil)
+	assert.Eventually(t, func() bool {
+		// the reload is triggered by the request
+		return decode() == 403 && c.policyVersion.Load() == 3
+	}, 1*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,151 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extauth
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/google/cel-go/cel"
+	"github.com/jellydator/ttlcache/v3"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/pkg/expr"
+	"mosn.io/htnn/types/plugins/extauth"
+)
+
+var (
+	decisionCacheLookups = api.DefineCounter("htnn.ext_auth.decision_cache.lookups")
+)
+
+const (
+	defaultDecisionCacheTTL      = 10 * time.Second
+	defaultDecisionCacheCapacity = 10000
+)
+
+// decision is the result of the authorization service which can be replayed to the other requests
+type decision struct {
+	// denied is set when the request is rejected
+	denied *api.LocalResponse
+	// upstreamHeaders is set to the client request when the HTTP service allows the request
+	upstreamHeaders http.Header
+	// okResponse is applied to the client request when the gRPC service allows the request
+	okResponse *authv3.OkHttpResponse
+	// cacheControl is the Cache-Control header returned by the authorization service
+	cacheControl string
+}
+
+type decisionCache struct {
+	keyHeaders []string
+	script     expr.Script
+	ttl        time.Duration
+	cache      *ttlcache.Cache[string, *decision]
+}
+
+func newDecisionCache(conf *extauth.DecisionCache) *decisionCache {
+	ttl := defaultDecisionCacheTTL
+	if conf.Ttl != nil {
+		ttl = conf.Ttl.AsDuration()
+	}
+	capacity := uint64(defaultDecisionCacheCapacity)
+	if conf.Capacity > 0 {
+		capacity = uint64(conf.Capacity)
+	}
+
+	c := &decisionCache{
+		keyHeaders: conf.KeyHeaders,
+		ttl:        ttl,
+		cache: ttlcache.New(
+			ttlcache.WithCapacity[string, *decision](capacity),
+			ttlcache.WithDisableTouchOnHit[string, *decision](),
+		),
+	}
+	if conf.Key != "" {
+		// the key is validated in the Validate method
+		c.script, _ = expr.CompileCel(conf.Key, cel.StringType)
+	}
+	go c.cache.Start()
+	return c
+}
+
+func (c *decisionCache) stop() {
+	c.cache.Stop()
+}
+
+// key returns the cache key of the request. The request can't use the cache if it returns false.
+func (c *decisionCache) key(callbacks api.FilterCallbackHandler, headers api.RequestHeaderMap) (string, bool) {
+	parts := make([]string, 0, len(c.keyHeaders)+1)
+	for _, h := range c.keyHeaders {
+		parts = append(parts, strings.Join(headers.Values(h), ","))
+	}
+	if c.script != nil {
+		res, err := c.script.EvalWithRequest(callbacks, headers)
+		if err != nil {
+			api.LogErrorf("failed to eval script with request: %v", err)
+			return "", false
+		}
+		parts = append(parts, res.(string))
+	}
+
+	// Don't share the decision among the requests without the credential
+	for _, p := range parts {
+		if p != "" {
+			// header values can't contain '\n'
+			return strings.Join(parts, "\n"), true
+		}
+	}
+	return "", false
+}
+
+func (c *decisionCache) get(key string) *decision {
+	item := c.cache.Get(key)
+	if item == nil {
+		decisionCacheLookups.WithTags(api.MetricTag{Key: "result", Value: "miss"}).Increment(1)
+		return nil
+	}
+	decisionCacheLookups.WithTags(api.MetricTag{Key: "result", Value: "hit"}).Increment(1)
+	return item.Value()
+}
+
+func (c *decisionCache) set(key string, d *decision) {
+	ttl := cacheTTL(d.cacheControl, c.ttl)
+	if ttl <= 0 {
+		return
+	}
+	c.cache.Set(key, d, ttl)
+}
+
+// cacheTTL returns the TTL given by `Cache-Control: max-age`. Zero is returned if the decision
+// can't be cached.
+func cacheTTL(cacheControl string, defaultTTL time.Duration) time.Duration {
+	ttl := defaultTTL
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store" || directive == "no-cache":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			age, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err != nil || age <= 0 {
+				return 0
+			}
+			ttl = time.Duration(age) * time.Second
+		}
+	}
+	return ttl
+}
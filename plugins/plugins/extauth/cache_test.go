@@ -0,0 +1,192 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+)
+
+func TestCacheTTL(t *testing.T) {
+	tests := []struct {
+		cacheControl string
+		ttl          time.Duration
+	}{
+		{"", 10 * time.Second},
+		{"max-age=60", time.Minute},
+		{"public, Max-Age=5", 5 * time.Second},
+		{"max-age=0", 0},
+		{"max-age=x", 0},
+		{"no-store", 0},
+		{"max-age=60, no-cache", 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.ttl, cacheTTL(tt.cacheControl, 10*time.Second), tt.cacheControl)
+	}
+}
+
+func lookups(result string) uint64 {
+	return decisionCacheLookups.WithTags(api.MetricTag{Key: "result", Value: result}).Get()
+}
+
+func TestDecisionCacheHTTP(t *testing.T) {
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{
+		"httpService": {
+			"url": "http://127.0.0.1:10001/extauth",
+			"authorizationResponse": {
+				"allowedUpstreamHeaders": [{"exact": "x-user"}]
+			}
+		},
+		"decisionCache": {
+			"keyHeaders": ["authorization"]
+		}
+	}`), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	calls := 0
+	patches := gomonkey.ApplyMethodFunc(conf.client, "Do", func(r *http.Request) (*http.Response, error) {
+		calls++
+		switch r.Header.Get("authorization") {
+		case "good":
+			resp := response(200)
+			resp.Header.Set("x-user", "rick")
+			return resp, nil
+		case "short":
+			resp := response(200)
+			resp.Header.Set("cache-control", "max-age=1")
+			return resp, nil
+		case "no-store":
+			resp := response(200)
+			resp.Header.Set("cache-control", "no-store")
+			return resp, nil
+		case "error":
+			return response(503), nil
+		}
+		resp := response(401)
+		resp.Header.Set("www-authenticate", "Basic")
+		return resp, nil
+	})
+	defer patches.Reset()
+
+	send := func(authz string) (api.ResultAction, api.RequestHeaderMap) {
+		cb := envoy.NewFilterCallbackHandler()
+		f := factory(conf, cb)
+		hdr := envoy.NewRequestHeaderMap(http.Header{
+			":authority": {"test.local"},
+			":method":    {"GET"},
+			":path":      {"/"},
+		})
+		if authz != "" {
+			hdr.Set("authorization", authz)
+		}
+		return f.DecodeHeaders(hdr, true), hdr
+	}
+
+	hits := lookups("hit")
+	misses := lookups("miss")
+	for i := 0; i < 3; i++ {
+		res, hdr := send("good")
+		assert.Equal(t, api.Continue, res)
+		assert.Equal(t, "rick", hdr.Values("x-user")[0])
+	}
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, hits+2, lookups("hit"))
+	assert.Equal(t, misses+1, lookups("miss"))
+
+	for i := 0; i < 2; i++ {
+		res, _ := send("bad")
+		r, ok := res.(*api.LocalResponse)
+		require.True(t, ok)
+		assert.Equal(t, 401, r.Code)
+		assert.Equal(t, "Basic", r.Header.Get("www-authenticate"))
+		// the cached decision is not affected
+		r.Header.Set("www-authenticate", "Bearer")
+	}
+	res, _ := send("bad")
+	assert.Equal(t, "Basic", res.(*api.LocalResponse).Header.Get("www-authenticate"))
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	for _, authz := range []string{"no-store", "error", ""} {
+		send(authz)
+		send(authz)
+	}
+	assert.Equal(t, 6, calls)
+
+	calls = 0
+	send("short")
+	send("short")
+	assert.Equal(t, 1, calls)
+	time.Sleep(1100 * time.Millisecond)
+	send("short")
+	assert.Equal(t, 2, calls)
+}
+
+func TestDecisionCacheGrpc(t *testing.T) {
+	srv := &authServer{}
+	addr := startAuthServer(t, srv)
+
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{
+		"grpcService": {"address": "`+addr+`"},
+		"decisionCache": {"key": "request.header('x-token') + ':' + request.path()"}
+	}`), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	calls := 0
+	srv.check = func(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+		calls++
+		return okResponse(&authv3.OkHttpResponse{
+			Headers: []*corev3.HeaderValueOption{
+				headerOption("x-user", "rick", 0),
+			},
+		}), nil
+	}
+
+	send := func(path string) api.RequestHeaderMap {
+		cb := envoy.NewFilterCallbackHandler()
+		f := factory(conf, cb)
+		hdr := envoy.NewRequestHeaderMap(http.Header{
+			":authority": {"test.local"},
+			":method":    {"GET"},
+			":path":      {path},
+		})
+		hdr.Set("x-token", "t")
+		assert.Equal(t, api.Continue, f.DecodeHeaders(hdr, true))
+		return hdr
+	}
+
+	assert.Equal(t, []string{"rick"}, send("/a").Values("x-user"))
+	assert.Equal(t, []string{"rick"}, send("/a").Values("x-user"))
+	assert.Equal(t, 1, calls)
+	send("/b")
+	assert.Equal(t, 2, calls)
+}
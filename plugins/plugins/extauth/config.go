@@ -15,11 +15,19 @@
 package extauth
 
 import (
+	"crypto/tls"
 	"net/http"
+	"runtime"
 	"time"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/pkg/request"
 	"mosn.io/htnn/types/pkg/expr"
 	"mosn.io/htnn/types/plugins/extauth"
 )
@@ -41,21 +49,55 @@ func (p *plugin) Config() api.PluginConfig {
 }
 
 type config struct {
-	extauth.Config
+	extauth.CustomConfig
 
 	client                  *http.Client
+	grpcClient              authv3.AuthorizationClient
+	grpcConn                *grpc.ClientConn
+	decisionCache           *decisionCache
 	headerToUpstreamMatcher expr.Matcher
 	headerToClientMatcher   expr.Matcher
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if dc := conf.GetDecisionCache(); dc != nil {
+		conf.decisionCache = newDecisionCache(dc)
+	}
+	if conf.decisionCache != nil || conf.GetGrpcService() != nil {
+		runtime.SetFinalizer(conf, func(conf *config) {
+			if conf.decisionCache != nil {
+				conf.decisionCache.stop()
+			}
+			if conf.grpcConn != nil {
+				err := conf.grpcConn.Close()
+				if err != nil {
+					api.LogErrorf("failed to close grpc connection, err: %v", err)
+				}
+			}
+		})
+	}
+
+	if gs := conf.GetGrpcService(); gs != nil {
+		return conf.initGrpcClient(gs)
+	}
+
 	du := 200 * time.Millisecond
 	timeout := conf.GetHttpService().GetTimeout()
 	if timeout != nil {
 		du = timeout.AsDuration()
 	}
 
-	conf.client = &http.Client{Timeout: du}
+	client, err := request.NewClient(request.ClientOptions{
+		Name:           extauth.Name,
+		Timeout:        du,
+		TLS:            request.NewTLSOptions(conf.GetHttpService().GetTls()),
+		Retry:          request.NewRetryPolicy(conf.GetHttpService().GetRetry()),
+		CircuitBreaker: request.NewCircuitBreakerPolicy(conf.GetHttpService().GetCircuitBreaker()),
+	})
+	if err != nil {
+		return err
+	}
+	conf.client = client
 
 	resp := conf.GetHttpService().GetAuthorizationResponse()
 	if resp != nil {
@@ -76,3 +118,25 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	}
 	return nil
 }
+
+func (conf *config) initGrpcClient(gs *extauth.GrpcService) error {
+	creds := insecure.NewCredentials()
+	if gs.Tls {
+		creds = credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: gs.TlsSkipVerify,
+		})
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if gs.Authority != "" {
+		opts = append(opts, grpc.WithAuthority(gs.Authority))
+	}
+
+	// The connection is established lazily, so the config can be created when the server is down
+	conn, err := grpc.NewClient(gs.Address, opts...)
+	if err != nil {
+		return err
+	}
+	conf.grpcConn = conn
+	conf.grpcClient = authv3.NewAuthorizationClient(conn)
+	return nil
+}
@@ -32,6 +32,16 @@ func TestConfTimeout(t *testing.T) {
 	assert.Equal(t, 10*time.Second, conf.client.Timeout)
 }
 
+func TestConfTLS(t *testing.T) {
+	s := `{"httpService":{
+		"url": "https://127.0.0.1",
+		"tls": {"caCert": "bad"}
+	}}`
+	conf := &config{}
+	protojson.Unmarshal([]byte(s), conf)
+	assert.ErrorContains(t, conf.Init(nil), "failed to parse CA certificate")
+}
+
 func TestBadConfig(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -53,6 +63,41 @@ func TestBadConfig(t *testing.T) {
 			input: `{"httpService":{"url":"http://127.0.0.1","timeout":"-1s"}}`,
 			err:   "invalid HttpService.Timeout: value must be greater than 0s",
 		},
+		{
+			name:  "invalid HttpService.Retry",
+			input: `{"httpService":{"url":"http://127.0.0.1","retry":{"maxRetries":11}}}`,
+			err:   "invalid HTTPRetryPolicy.MaxRetries: value must be less than or equal to 10",
+		},
+		{
+			name:  "invalid HttpService.CircuitBreaker",
+			input: `{"httpService":{"url":"http://127.0.0.1","circuitBreaker":{"openDuration":"0s"}}}`,
+			err:   "invalid HTTPCircuitBreaker.OpenDuration: value must be greater than 0s",
+		},
+		{
+			name:  "invalid GrpcService.Address",
+			input: `{"grpcService":{"address":""}}`,
+			err:   "invalid GrpcService.Address: value length must be at least 1 runes",
+		},
+		{
+			name:  "decision cache without key",
+			input: `{"httpService":{"url":"http://127.0.0.1"},"decisionCache":{}}`,
+			err:   "key_headers or key is required in decision_cache",
+		},
+		{
+			name:  "invalid decision cache key",
+			input: `{"httpService":{"url":"http://127.0.0.1"},"decisionCache":{"key":"request.host() == 'a'"}}`,
+			err:   "wanted string",
+		},
+		{
+			name:  "decision cache with request body",
+			input: `{"httpService":{"url":"http://127.0.0.1","withRequestBody":true},"decisionCache":{"keyHeaders":["authorization"]}}`,
+			err:   "decision_cache can't be used with with_request_body",
+		},
+		{
+			name:  "decision cache with request body, grpc",
+			input: `{"grpcService":{"address":"127.0.0.1:8080","withRequestBody":true},"decisionCache":{"keyHeaders":["authorization"]}}`,
+			err:   "decision_cache can't be used with with_request_body",
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,6 +133,14 @@ func TestValidConfig(t *testing.T) {
 				"authorizationResponse":{}
 			}}`,
 		},
+		{
+			name: "grpc service",
+			input: `{"grpcService":{
+				"address":"127.0.0.1:9000",
+				"tls":true,
+				"initialMetadata":[{"key":"foo","value":"bar"}]
+			}}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -20,6 +20,8 @@ import (
 	"net/http"
 	"net/url"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 )
 
@@ -35,21 +37,86 @@ type filter struct {
 
 	callbacks api.FilterCallbackHandler
 	config    *config
+
+	respHeadersToAdd []*corev3.HeaderValueOption
+}
+
+// onError applies the failure_mode_allow semantics when the authorization service is unavailable
+func (f *filter) onError(headers api.RequestHeaderMap, statusOnError int) api.ResultAction {
+	if f.config.GetFailureModeAllow() {
+		if f.config.GetFailureModeAllowHeaderAdd() {
+			headers.Set("x-envoy-auth-failure-mode-allowed", "true")
+		}
+		return api.Continue
+	}
+	code := statusOnError
+	if code == 0 {
+		code = 403
+	}
+	return &api.LocalResponse{Code: code}
 }
 
 func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) api.ResultAction {
+	cache := f.config.decisionCache
+	key := ""
+	cacheable := false
+	if cache != nil {
+		key, cacheable = cache.key(f.callbacks, headers)
+		if cacheable {
+			if d := cache.get(key); d != nil {
+				return f.applyDecision(headers, d)
+			}
+		}
+	}
+
+	var d *decision
+	var res api.ResultAction
+	if f.config.grpcClient != nil {
+		d, res = f.checkGrpc(headers, data)
+	} else {
+		d, res = f.checkHTTP(headers, data)
+	}
+	if d == nil {
+		return res
+	}
+
+	if cacheable {
+		cache.set(key, d)
+	}
+	return f.applyDecision(headers, d)
+}
+
+func (f *filter) applyDecision(headers api.RequestHeaderMap, d *decision) api.ResultAction {
+	if d.denied != nil {
+		// the cached decision is shared, so copy it in case it's modified
+		res := *d.denied
+		res.Header = d.denied.Header.Clone()
+		return &res
+	}
+
+	for k, v := range d.upstreamHeaders {
+		headers.Set(k, v[len(v)-1])
+	}
+	if d.okResponse != nil {
+		f.applyOkResponse(headers, d.okResponse)
+	}
+	return api.Continue
+}
+
+// checkHTTP calls the HTTP authorization service. The ResultAction is returned if it fails.
+func (f *filter) checkHTTP(headers api.RequestHeaderMap, data api.BufferInstance) (*decision, api.ResultAction) {
 	hs := f.config.GetHttpService()
 	uri := hs.GetUrl()
 	path, err := url.JoinPath(uri, headers.Path())
 	if err != nil {
 		api.LogWarnf("failed to join path: %v", err)
-		return &api.LocalResponse{Code: 503}
+		return nil, &api.LocalResponse{Code: 503}
 	}
 
-	req, err := http.NewRequest(headers.Method(), path, bytes.NewReader([]byte{}))
+	req, err := http.NewRequestWithContext(f.callbacks.Context(), headers.Method(), path, bytes.NewReader([]byte{}))
 	if err != nil {
 		api.LogWarnf("failed to new request to ext authz server: %v", err)
-		return &api.LocalResponse{Code: 503}
+		return nil, &api.LocalResponse{Code: 503}
 	}
 	req.Host = headers.Host()
 	authz, ok := headers.Get("authorization")
@@ -74,20 +141,13 @@ func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) ap
 		} else {
 			api.LogWarnf("failed to call ext authz server: %s", rsp.Status)
 		}
-		if f.config.GetFailureModeAllow() {
-			if f.config.GetFailureModeAllowHeaderAdd() {
-				headers.Set("x-envoy-auth-failure-mode-allowed", "true")
-			}
-			return api.Continue
-		}
-		code := int(hs.GetStatusOnError())
-		if code == 0 {
-			code = 403
-		}
-		return &api.LocalResponse{Code: code}
+		return nil, f.onError(headers, int(hs.GetStatusOnError()))
 	}
 
 	rsp.Body.Close()
+	d := &decision{
+		cacheControl: rsp.Header.Get("Cache-Control"),
+	}
 	if rsp.StatusCode != 200 {
 		rspHdr := rsp.Header
 		if f.config.headerToClientMatcher != nil {
@@ -100,21 +160,23 @@ func (f *filter) check(headers api.RequestHeaderMap, data api.BufferInstance) ap
 				}
 			}
 		}
-		return &api.LocalResponse{Code: rsp.StatusCode, Header: rspHdr}
+		d.denied = &api.LocalResponse{Code: rsp.StatusCode, Header: rspHdr}
+		return d, nil
 	}
 
 	if f.config.headerToUpstreamMatcher != nil {
+		d.upstreamHeaders = http.Header{}
 		for k, v := range rsp.Header {
 			if f.config.headerToUpstreamMatcher.Match(k) {
-				headers.Set(k, v[len(v)-1])
+				d.upstreamHeaders[k] = v
 			}
 		}
 	}
-	return api.Continue
+	return d, nil
 }
 
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	if f.config.GetHttpService().GetWithRequestBody() {
+	if f.config.GetHttpService().GetWithRequestBody() || f.config.GetGrpcService().GetWithRequestBody() {
 		return api.WaitAllData
 	}
 	return f.check(headers, nil)
@@ -123,3 +185,8 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
 	return f.check(headers, data)
 }
+
+func (f *filter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
+	applyHeaderMutation(headers, f.respHeadersToAdd)
+	return api.Continue
+}
@@ -0,0 +1,237 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extauth
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+func toPeer(address string) *authv3.AttributeContext_Peer {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil
+	}
+	p, _ := strconv.ParseUint(port, 10, 32)
+	return &authv3.AttributeContext_Peer{
+		Address: &corev3.Address{
+			Address: &corev3.Address_SocketAddress{
+				SocketAddress: &corev3.SocketAddress{
+					Address: host,
+					PortSpecifier: &corev3.SocketAddress_PortValue{
+						PortValue: uint32(p),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (f *filter) buildCheckRequest(headers api.RequestHeaderMap, data api.BufferInstance) *authv3.CheckRequest {
+	// Like Envoy, the headers with the same name are joined with ','
+	hdrs := map[string]string{}
+	headers.Range(func(k, v string) bool {
+		k = strings.ToLower(k)
+		if old, ok := hdrs[k]; ok {
+			hdrs[k] = old + "," + v
+		} else {
+			hdrs[k] = v
+		}
+		return true
+	})
+
+	requestID, _ := headers.Get("x-request-id")
+	httpReq := &authv3.AttributeContext_HttpRequest{
+		Id:      requestID,
+		Method:  headers.Method(),
+		Headers: hdrs,
+		Path:    headers.Path(),
+		Host:    headers.Host(),
+		Scheme:  headers.Scheme(),
+		Size:    -1,
+	}
+	info := f.callbacks.StreamInfo()
+	if protocol, ok := info.Protocol(); ok {
+		httpReq.Protocol = protocol
+	}
+	if data != nil {
+		body := data.Bytes()
+		httpReq.Body = string(body)
+		httpReq.Size = int64(len(body))
+	}
+
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Source:      toPeer(info.DownstreamRemoteAddress()),
+			Destination: toPeer(info.DownstreamLocalAddress()),
+			Request: &authv3.AttributeContext_Request{
+				Time: timestamppb.Now(),
+				Http: httpReq,
+			},
+		},
+	}
+}
+
+type headerAdder interface {
+	Get(key string) (string, bool)
+	Set(key, value string)
+	Add(key, value string)
+}
+
+type httpHeader http.Header
+
+func (h httpHeader) Get(key string) (string, bool) {
+	v := http.Header(h).Values(key)
+	if len(v) == 0 {
+		return "", false
+	}
+	return v[0], true
+}
+
+func (h httpHeader) Set(key, value string) {
+	http.Header(h).Set(key, value)
+}
+
+func (h httpHeader) Add(key, value string) {
+	http.Header(h).Add(key, value)
+}
+
+// applyHeaderMutation follows the way how Envoy's ext_authz handles the HeaderValueOption:
+// the deprecated `append` takes precedence over the `append_action`.
+func applyHeaderMutation(headers headerAdder, options []*corev3.HeaderValueOption) {
+	for _, opt := range options {
+		h := opt.GetHeader()
+		if h == nil {
+			continue
+		}
+		key := h.Key
+		value := h.Value
+		if value == "" && len(h.RawValue) > 0 {
+			value = string(h.RawValue)
+		}
+
+		if opt.Append != nil {
+			if opt.Append.Value {
+				headers.Add(key, value)
+			} else {
+				headers.Set(key, value)
+			}
+			continue
+		}
+
+		_, exists := headers.Get(key)
+		switch opt.AppendAction {
+		case corev3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD:
+			headers.Add(key, value)
+		case corev3.HeaderValueOption_ADD_IF_ABSENT:
+			if !exists {
+				headers.Set(key, value)
+			}
+		case corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD:
+			headers.Set(key, value)
+		case corev3.HeaderValueOption_OVERWRITE_IF_EXISTS:
+			if exists {
+				headers.Set(key, value)
+			}
+		}
+	}
+}
+
+func (f *filter) applyOkResponse(headers api.RequestHeaderMap, rsp *authv3.OkHttpResponse) {
+	applyHeaderMutation(headers, rsp.GetHeaders())
+	for _, k := range rsp.GetHeadersToRemove() {
+		// Like Envoy, the pseudo headers and the host can't be removed
+		if strings.HasPrefix(k, ":") || strings.EqualFold(k, "host") {
+			continue
+		}
+		headers.Del(k)
+	}
+
+	if len(rsp.GetQueryParametersToSet()) > 0 || len(rsp.GetQueryParametersToRemove()) > 0 {
+		u := headers.URL()
+		query := u.Query()
+		for _, k := range rsp.GetQueryParametersToRemove() {
+			query.Del(k)
+		}
+		for _, p := range rsp.GetQueryParametersToSet() {
+			query.Set(p.Key, p.Value)
+		}
+		u.RawQuery = query.Encode()
+		headers.SetPath(u.RequestURI())
+	}
+
+	f.respHeadersToAdd = rsp.GetResponseHeadersToAdd()
+}
+
+// checkGrpc calls the gRPC authorization service. The ResultAction is returned if it fails.
+func (f *filter) checkGrpc(headers api.RequestHeaderMap, data api.BufferInstance) (*decision, api.ResultAction) {
+	gs := f.config.GetGrpcService()
+	du := 200 * time.Millisecond
+	if gs.GetTimeout() != nil {
+		du = gs.GetTimeout().AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(f.callbacks.Context(), du)
+	defer cancel()
+	for _, md := range gs.GetInitialMetadata() {
+		ctx = metadata.AppendToOutgoingContext(ctx, md.Key, md.Value)
+	}
+
+	rsp, err := f.config.grpcClient.Check(ctx, f.buildCheckRequest(headers, data))
+	if err != nil {
+		api.LogWarnf("failed to call ext authz server: %v", err)
+		return nil, f.onError(headers, int(gs.GetStatusOnError()))
+	}
+
+	if rsp.GetStatus().GetCode() == int32(code.Code_OK) {
+		ok := rsp.GetOkResponse()
+		return &decision{
+			okResponse:   ok,
+			cacheControl: findHeader(ok.GetResponseHeadersToAdd(), "cache-control"),
+		}, nil
+	}
+
+	denied := rsp.GetDeniedResponse()
+	status := int(denied.GetStatus().GetCode())
+	if status == 0 {
+		status = 403
+	}
+	hdr := http.Header{}
+	applyHeaderMutation(httpHeader(hdr), denied.GetHeaders())
+	return &decision{
+		denied:       &api.LocalResponse{Code: status, Msg: denied.GetBody(), Header: hdr},
+		cacheControl: hdr.Get("cache-control"),
+	}, nil
+}
+
+func findHeader(options []*corev3.HeaderValueOption, key string) string {
+	for _, opt := range options {
+		if strings.EqualFold(opt.GetHeader().GetKey(), key) {
+			return opt.GetHeader().GetValue()
+		}
+	}
+	return ""
+}
@@ -15,6 +15,8 @@
 package hmacauth
 
 import (
+	"runtime"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/types/plugins/hmacauth"
@@ -31,3 +33,33 @@ type plugin struct {
 func (p *plugin) Factory() api.FilterFactory {
 	return factory
 }
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type config struct {
+	hmacauth.CustomConfig
+
+	replayCache replayCache
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if conf.NonceHeader == "" {
+		return nil
+	}
+
+	if redisConf := conf.GetRedisReplayCache(); redisConf != nil {
+		cache := newRedisReplayCache(redisConf)
+		conf.replayCache = cache
+		runtime.SetFinalizer(conf, func(conf *config) {
+			err := cache.Close()
+			if err != nil {
+				api.LogErrorf("failed to close redis client, err: %v", err)
+			}
+		})
+	} else {
+		conf.replayCache = getLocalReplayCache(conf.NonceHeader, conf.GetLocalReplayCache().GetCapacity())
+	}
+	return nil
+}
@@ -34,6 +34,15 @@ func TestConsumerConfig(t *testing.T) {
 			input: `{"accessKey":"a", "secretKey":"s", "signedHeaders":[""]}`,
 			err:   "value length must be at least 1 runes",
 		},
+		{
+			name:  "allowed algorithms",
+			input: `{"accessKey":"a", "secretKey":"s", "allowedAlgorithms":["HMAC_SHA384", "HMAC_SHA512"]}`,
+		},
+		{
+			name:  "undefined algorithm",
+			input: `{"accessKey":"a", "secretKey":"s", "allowedAlgorithms":[9]}`,
+			err:   "value must be one of the defined enum values",
+		},
 	}
 
 	for _, tt := range tests {
@@ -51,3 +60,55 @@ func TestConsumerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "nonce",
+			input: `{"nonceHeader":"x-nonce", "clockSkew":"300s", "redisReplayCache":{"address":"127.0.0.1:6379"}}`,
+		},
+		{
+			name:  "nonce without clock skew",
+			input: `{"nonceHeader":"x-nonce"}`,
+			err:   "clock_skew is required when nonce_header is set",
+		},
+		{
+			name:  "replay cache without nonce",
+			input: `{"clockSkew":"300s", "localReplayCache":{}}`,
+			err:   "nonce_header is required when the replay cache is set",
+		},
+		{
+			name:  "invalid clock skew",
+			input: `{"clockSkew":"0s"}`,
+			err:   "invalid Config.ClockSkew",
+		},
+		{
+			name:  "signed headers and body digest",
+			input: `{"signedHeaders":["x-a"], "bodyDigest":true}`,
+		},
+		{
+			name:  "empty signed header",
+			input: `{"signedHeaders":[""]}`,
+			err:   "invalid Config.SignedHeaders",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &hmacauth.CustomConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -20,9 +20,11 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"hash"
+	"net/http"
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/types/plugins/hmacauth"
@@ -31,7 +33,7 @@ import (
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &filter{
 		callbacks: callbacks,
-		config:    c.(*hmacauth.Config),
+		config:    c.(*config),
 	}
 }
 
@@ -39,8 +41,10 @@ type filter struct {
 	api.PassThroughFilter
 
 	callbacks api.FilterCallbackHandler
-	config    *hmacauth.Config
+	config    *config
 	consumer  *hmacauth.ConsumerConfig
+	algorithm hmacauth.Algorithm
+	digest    []byte
 }
 
 // This plugin uses the same hmac auth scheme as APISIX:
@@ -49,15 +53,40 @@ const (
 	DateHeader      = "date"
 	SignatureHeader = "x-hmac-signature"
 	AccessKeyHeader = "x-hmac-access-key"
-	// TODO: support algorithm / signed header filters
+	AlgorithmHeader = "x-hmac-algorithm"
+
+	ContentDigestHeader = "content-digest"
+	DigestHeader        = "digest"
 )
 
-func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) string {
-	dh := DateHeader
+func (f *filter) dateHeader() string {
 	if f.config.DateHeader != "" {
-		dh = f.config.DateHeader
+		return f.config.DateHeader
+	}
+	return DateHeader
+}
+
+// signedHeaders returns the route's signed headers, then the consumer's ones, without duplicate
+func (f *filter) signedHeaders(digestHeader string) []string {
+	hdrs := make([]string, 0, len(f.config.SignedHeaders)+len(f.consumer.SignedHeaders)+1)
+	for _, h := range f.config.SignedHeaders {
+		if !slices.Contains(hdrs, h) {
+			hdrs = append(hdrs, h)
+		}
+	}
+	for _, h := range f.consumer.SignedHeaders {
+		if !slices.Contains(hdrs, h) {
+			hdrs = append(hdrs, h)
+		}
+	}
+	if digestHeader != "" && !slices.Contains(hdrs, digestHeader) {
+		hdrs = append(hdrs, digestHeader)
 	}
-	date, _ := header.Get(dh)
+	return hdrs
+}
+
+func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string, signedHeaders []string) string {
+	date, _ := header.Get(f.dateHeader())
 	url := header.URL()
 	path := url.Path
 	if path == "" {
@@ -99,7 +128,12 @@ func (f *filter) getSignContent(header api.RequestHeaderMap, accessKey string) s
 	buf.WriteByte('\n')
 	buf.WriteString(date)
 	buf.WriteByte('\n')
-	for _, h := range f.consumer.SignedHeaders {
+	if f.config.NonceHeader != "" {
+		nonce, _ := header.Get(f.config.NonceHeader)
+		buf.WriteString(nonce)
+		buf.WriteByte('\n')
+	}
+	for _, h := range signedHeaders {
 		hs := header.Values(h)
 		slices.Sort(hs)
 		for _, v := range hs {
@@ -117,7 +151,7 @@ func (f *filter) sign(value []byte) string {
 	secret := []byte(f.consumer.SecretKey)
 
 	var hash hash.Hash
-	switch f.consumer.Algorithm {
+	switch f.algorithm {
 	case hmacauth.Algorithm_HMAC_SHA256:
 		hash = hmac.New(sha256.New, secret)
 	case hmacauth.Algorithm_HMAC_SHA384:
@@ -154,9 +188,28 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 		return &api.LocalResponse{Code: 401, Msg: "invalid access key"}
 	}
 
+	if config.NonceHeader != "" {
+		if nonce, _ := headers.Get(config.NonceHeader); nonce == "" {
+			return &api.LocalResponse{Code: 401, Msg: "missing nonce"}
+		}
+	}
+
 	f.consumer = c.PluginConfig(name).(*hmacauth.ConsumerConfig)
+	if res := f.chooseAlgorithm(headers); res != nil {
+		return res
+	}
+
+	digestHeader := ""
+	if config.BodyDigest {
+		var res api.ResultAction
+		digestHeader, res = f.getBodyDigest(headers)
+		if res != nil {
+			return res
+		}
+	}
+
 	signature, _ := headers.Get(sh)
-	signContent := f.getSignContent(headers, accessKey)
+	signContent := f.getSignContent(headers, accessKey, f.signedHeaders(digestHeader))
 	generatedSign := f.sign([]byte(signContent))
 	if signature != generatedSign {
 		api.LogInfof("signature mismatch: expected %s != actual %s, source: %q",
@@ -164,9 +217,152 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 		return &api.LocalResponse{Code: 401, Msg: "invalid signature"}
 	}
 
+	if config.ClockSkew != nil {
+		if res := f.checkReplay(headers, accessKey); res != nil {
+			return res
+		}
+	}
+
 	// drop sensitive headers
 	headers.Del(akh)
 	headers.Del(sh)
 	f.callbacks.SetConsumer(c)
+
+	if config.BodyDigest {
+		if endStream {
+			return f.checkBodyDigest(nil)
+		}
+		return api.WaitAllData
+	}
 	return api.Continue
 }
+
+func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	if f.digest == nil {
+		return api.Continue
+	}
+
+	var body []byte
+	if data != nil {
+		body = data.Bytes()
+	}
+	return f.checkBodyDigest(body)
+}
+
+// chooseAlgorithm uses the algorithm in the request if it's allowed, otherwise the consumer's algorithm
+func (f *filter) chooseAlgorithm(headers api.RequestHeaderMap) api.ResultAction {
+	f.algorithm = f.consumer.Algorithm
+
+	ah := AlgorithmHeader
+	if f.config.AlgorithmHeader != "" {
+		ah = f.config.AlgorithmHeader
+	}
+	value, ok := headers.Get(ah)
+	if !ok {
+		return nil
+	}
+
+	// Both "HMAC_SHA512" and "hmac-sha512" are accepted
+	v, ok := hmacauth.Algorithm_value[strings.ToUpper(strings.ReplaceAll(value, "-", "_"))]
+	if !ok {
+		api.LogInfof("unknown algorithm %s in %s", value, ah)
+		return &api.LocalResponse{Code: 401, Msg: "invalid algorithm"}
+	}
+	algo := hmacauth.Algorithm(v)
+	if algo != f.consumer.Algorithm && !slices.Contains(f.consumer.AllowedAlgorithms, algo) {
+		api.LogInfof("algorithm %s is not allowed for access key %s", value, f.consumer.AccessKey)
+		return &api.LocalResponse{Code: 401, Msg: "invalid algorithm"}
+	}
+	f.algorithm = algo
+	return nil
+}
+
+// parseDigest looks for the SHA-256 digest in the digest header. The `Content-Digest` is like
+// `sha-256=:base64:` (RFC 9530), and the `Digest` is like `SHA-256=base64` (RFC 3230).
+func parseDigest(value string) []byte {
+	for _, item := range strings.Split(value, ",") {
+		algo, digest, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found || !strings.EqualFold(algo, "sha-256") {
+			continue
+		}
+		digest = strings.TrimSuffix(strings.TrimPrefix(digest, ":"), ":")
+		b, err := base64.StdEncoding.DecodeString(digest)
+		if err != nil || len(b) != sha256.Size {
+			return nil
+		}
+		return b
+	}
+	return nil
+}
+
+// getBodyDigest returns the name of the digest header, which is preferred to be `Content-Digest`
+func (f *filter) getBodyDigest(headers api.RequestHeaderMap) (string, api.ResultAction) {
+	for _, h := range []string{ContentDigestHeader, DigestHeader} {
+		value, ok := headers.Get(h)
+		if !ok {
+			continue
+		}
+		f.digest = parseDigest(value)
+		if f.digest == nil {
+			api.LogInfof("invalid body digest %s: %s", h, value)
+			return "", &api.LocalResponse{Code: 401, Msg: "invalid body digest"}
+		}
+		return h, nil
+	}
+	return "", &api.LocalResponse{Code: 401, Msg: "missing body digest"}
+}
+
+func (f *filter) checkBodyDigest(body []byte) api.ResultAction {
+	sum := sha256.Sum256(body)
+	if !hmac.Equal(sum[:], f.digest) {
+		api.LogInfof("body digest mismatch, body length: %d", len(body))
+		return &api.LocalResponse{Code: 401, Msg: "body digest mismatch"}
+	}
+	return api.Continue
+}
+
+// parseDate accepts the HTTP date like "Mon, 02 Jan 2006 15:04:05 GMT" and the RFC 3339 date
+func parseDate(s string) (time.Time, error) {
+	if t, err := http.ParseTime(s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// checkReplay rejects the request which is signed too long ago, or whose nonce is already used
+func (f *filter) checkReplay(headers api.RequestHeaderMap, accessKey string) api.ResultAction {
+	value, _ := headers.Get(f.dateHeader())
+	date, err := parseDate(value)
+	if err != nil {
+		api.LogInfof("invalid date %q: %v", value, err)
+		return &api.LocalResponse{Code: 401, Msg: "invalid date"}
+	}
+
+	skew := f.config.ClockSkew.AsDuration()
+	now := time.Now()
+	if date.Before(now.Add(-skew)) || date.After(now.Add(skew)) {
+		api.LogInfof("date %q is out of the clock skew %s", value, skew)
+		return &api.LocalResponse{Code: 401, Msg: "invalid date"}
+	}
+
+	if f.config.replayCache == nil {
+		return nil
+	}
+
+	// The request is accepted until date + skew, so the nonce is recorded until then
+	ttl := date.Add(skew).Sub(now)
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	nonce, _ := headers.Get(f.config.NonceHeader)
+	added, err := f.config.replayCache.Add(f.callbacks.Context(), accessKey+":"+nonce, ttl)
+	if err != nil {
+		api.LogErrorf("failed to record nonce: %v", err)
+		return &api.LocalResponse{Code: 503, Msg: "failed to check nonce"}
+	}
+	if !added {
+		api.LogInfof("nonce %s of access key %s is reused", nonce, accessKey)
+		return &api.LocalResponse{Code: 401, Msg: "nonce is reused"}
+	}
+	return nil
+}
@@ -15,10 +15,13 @@
 package hmacauth
 
 import (
+	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
+	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -92,10 +95,11 @@ func TestHmacAuth(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cb := envoy.NewFilterCallbackHandler()
-			conf := &hmacauth.Config{}
+			conf := &config{}
 			if tt.conf != "" {
 				protojson.Unmarshal([]byte(tt.conf), conf)
 			}
+			require.NoError(t, conf.Init(nil))
 			f := factory(conf, cb)
 			defaultHdr := map[string][]string{
 				":authority": {"test.local"},
@@ -127,3 +131,307 @@ func TestHmacAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestHmacAuthReplay(t *testing.T) {
+	s, err := miniredis.Run()
+	require.NoError(t, err)
+	defer s.Close()
+
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		hmacauth.Name: &hmacauth.ConsumerConfig{
+			AccessKey: "ak",
+			SecretKey: "sk",
+		},
+	})
+
+	for _, cache := range []string{
+		`"localReplayCache":{}`,
+		`"redisReplayCache":{"address":"` + s.Addr() + `"}`,
+	} {
+		conf := &config{}
+		require.NoError(t, protojson.Unmarshal([]byte(`{"clockSkew":"300s","nonceHeader":"x-nonce",`+cache+`}`), conf))
+		require.NoError(t, conf.Validate())
+		require.NoError(t, conf.Init(nil))
+
+		send := func(date string, nonce string) api.ResultAction {
+			cb := envoy.NewFilterCallbackHandler()
+			patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+			defer patches.Reset()
+
+			f := factory(conf, cb).(*filter)
+			hdr := envoy.NewRequestHeaderMap(http.Header{
+				":authority": {"test.local"},
+				":method":    {"GET"},
+				":path":      {"/echo"},
+			})
+			hdr.Set(AccessKeyHeader, "ak")
+			hdr.Set(DateHeader, date)
+			if nonce != "" {
+				hdr.Set("x-nonce", nonce)
+			}
+			f.consumer = c.PluginConfig(hmacauth.Name).(*hmacauth.ConsumerConfig)
+			hdr.Set(SignatureHeader, f.sign([]byte(f.getSignContent(hdr, "ak", f.signedHeaders("")))))
+			return f.DecodeHeaders(hdr, true)
+		}
+
+		now := time.Now().UTC()
+		assert.Equal(t, api.Continue, send(now.Format(http.TimeFormat), "n1"), cache)
+		res, ok := send(now.Format(http.TimeFormat), "n1").(*api.LocalResponse)
+		require.True(t, ok, cache)
+		assert.Equal(t, "nonce is reused", res.Msg)
+		assert.Equal(t, api.Continue, send(now.Format(time.RFC3339), "n2"), cache)
+
+		res, ok = send(now.Add(-10*time.Minute).Format(http.TimeFormat), "n3").(*api.LocalResponse)
+		require.True(t, ok, cache)
+		assert.Equal(t, "invalid date", res.Msg)
+		res, ok = send("Fri Jan  5 16:10:54 CST 2024", "n4").(*api.LocalResponse)
+		require.True(t, ok, cache)
+		assert.Equal(t, "invalid date", res.Msg)
+		res, ok = send(now.Format(http.TimeFormat), "").(*api.LocalResponse)
+		require.True(t, ok, cache)
+		assert.Equal(t, "missing nonce", res.Msg)
+	}
+
+	// the nonce is recorded until the date is out of the clock skew
+	ttl := s.TTL("htnn:hmac_auth:nonce:ak:n1")
+	assert.True(t, ttl > 299*time.Second && ttl <= 300*time.Second, ttl)
+}
+
+func TestHmacAuthSignedHeadersAndAlgorithm(t *testing.T) {
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		hmacauth.Name: &hmacauth.ConsumerConfig{
+			AccessKey:         "ak",
+			SecretKey:         "sk",
+			SignedHeaders:     []string{"x-consumer", "x-route"},
+			AllowedAlgorithms: []hmacauth.Algorithm{hmacauth.Algorithm_HMAC_SHA512},
+		},
+	})
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"signedHeaders":["x-route"],"algorithmHeader":"x-algo"}`), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	tests := []struct {
+		name      string
+		algorithm string
+		signAlgo  hmacauth.Algorithm
+		tamper    string
+		msg       string
+	}{
+		{
+			name: "default algorithm",
+		},
+		{
+			name:      "allowed algorithm",
+			algorithm: "hmac-sha512",
+			signAlgo:  hmacauth.Algorithm_HMAC_SHA512,
+		},
+		{
+			name:      "consumer's algorithm",
+			algorithm: "HMAC_SHA256",
+		},
+		{
+			name:      "disallowed algorithm",
+			algorithm: "hmac-sha384",
+			signAlgo:  hmacauth.Algorithm_HMAC_SHA384,
+			msg:       "invalid algorithm",
+		},
+		{
+			name:      "unknown algorithm",
+			algorithm: "md5",
+			msg:       "invalid algorithm",
+		},
+		{
+			name:      "mismatched algorithm",
+			algorithm: "hmac-sha512",
+			msg:       "invalid signature",
+		},
+		{
+			name:   "tamper route's signed header",
+			tamper: "x-route",
+			msg:    "invalid signature",
+		},
+		{
+			name:   "tamper consumer's signed header",
+			tamper: "x-consumer",
+			msg:    "invalid signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+			defer patches.Reset()
+
+			f := factory(conf, cb).(*filter)
+			hdr := envoy.NewRequestHeaderMap(http.Header{
+				":authority": {"test.local"},
+				":method":    {"GET"},
+				":path":      {"/echo"},
+			})
+			hdr.Set(AccessKeyHeader, "ak")
+			hdr.Set(DateHeader, "Fri Jan  5 16:10:54 CST 2024")
+			hdr.Set("x-route", "a")
+			hdr.Set("x-consumer", "b")
+			if tt.algorithm != "" {
+				hdr.Set("x-algo", tt.algorithm)
+			}
+			f.consumer = c.PluginConfig(hmacauth.Name).(*hmacauth.ConsumerConfig)
+			f.algorithm = tt.signAlgo
+			hdr.Set(SignatureHeader, f.sign([]byte(f.getSignContent(hdr, "ak", f.signedHeaders("")))))
+			if tt.tamper != "" {
+				hdr.Set(tt.tamper, "c")
+			}
+
+			res := f.DecodeHeaders(hdr, true)
+			if tt.msg == "" {
+				assert.Equal(t, api.Continue, res)
+			} else {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.msg, r.Msg)
+			}
+		})
+	}
+}
+
+func TestHmacAuthBodyDigest(t *testing.T) {
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		hmacauth.Name: &hmacauth.ConsumerConfig{
+			AccessKey: "ak",
+			SecretKey: "sk",
+		},
+	})
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"bodyDigest":true}`), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	// the SHA-256 of "hello"
+	digest := "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="
+	tests := []struct {
+		name      string
+		hdr       map[string]string
+		body      string
+		endStream bool
+		tamper    string
+		msg       string
+	}{
+		{
+			name: "content-digest",
+			hdr:  map[string]string{ContentDigestHeader: "sha-512=:xx:, sha-256=:" + digest + ":"},
+			body: "hello",
+		},
+		{
+			name: "digest",
+			hdr:  map[string]string{DigestHeader: "SHA-256=" + digest},
+			body: "hello",
+		},
+		{
+			name: "body mismatch",
+			hdr:  map[string]string{DigestHeader: "SHA-256=" + digest},
+			body: "hello!",
+			msg:  "body digest mismatch",
+		},
+		{
+			name:      "no body",
+			hdr:       map[string]string{ContentDigestHeader: "sha-256=:47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=:"},
+			endStream: true,
+		},
+		{
+			name:      "no body but digest mismatch",
+			hdr:       map[string]string{ContentDigestHeader: "sha-256=:" + digest + ":"},
+			endStream: true,
+			msg:       "body digest mismatch",
+		},
+		{
+			name: "missing digest",
+			msg:  "missing body digest",
+		},
+		{
+			name: "invalid digest",
+			hdr:  map[string]string{ContentDigestHeader: "sha-256=:aGVsbG8=:"},
+			msg:  "invalid body digest",
+		},
+		{
+			name: "no sha-256 digest",
+			hdr:  map[string]string{ContentDigestHeader: "sha-512=:" + digest + ":"},
+			msg:  "invalid body digest",
+		},
+		{
+			name:   "digest header is signed",
+			hdr:    map[string]string{DigestHeader: "SHA-256=" + digest},
+			tamper: "SHA-256=47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+			msg:    "invalid signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+			defer patches.Reset()
+
+			f := factory(conf, cb).(*filter)
+			hdr := envoy.NewRequestHeaderMap(http.Header{
+				":authority": {"test.local"},
+				":method":    {"POST"},
+				":path":      {"/echo"},
+			})
+			hdr.Set(AccessKeyHeader, "ak")
+			hdr.Set(DateHeader, "Fri Jan  5 16:10:54 CST 2024")
+			digestHeader := ""
+			for k, v := range tt.hdr {
+				hdr.Set(k, v)
+				digestHeader = k
+			}
+			f.consumer = c.PluginConfig(hmacauth.Name).(*hmacauth.ConsumerConfig)
+			hdr.Set(SignatureHeader, f.sign([]byte(f.getSignContent(hdr, "ak", f.signedHeaders(digestHeader)))))
+			if tt.tamper != "" {
+				hdr.Set(digestHeader, tt.tamper)
+			}
+
+			res := f.DecodeHeaders(hdr, tt.endStream)
+			if !tt.endStream && res == api.WaitAllData {
+				res = f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(tt.body)), nil)
+			}
+			if tt.msg == "" {
+				assert.Equal(t, api.Continue, res)
+			} else {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.msg, r.Msg)
+			}
+		})
+	}
+}
+
+func TestLocalReplayCache(t *testing.T) {
+	cache := newLocalReplayCache(1)
+	added, _ := cache.Add(context.Background(), "a", 10*time.Millisecond)
+	assert.True(t, added)
+	added, _ = cache.Add(context.Background(), "a", 10*time.Millisecond)
+	assert.False(t, added)
+	time.Sleep(20 * time.Millisecond)
+	added, _ = cache.Add(context.Background(), "a", 10*time.Millisecond)
+	assert.True(t, added)
+}
+
+func TestLocalReplayCacheSharedAmongConfigs(t *testing.T) {
+	newConf := func() *config {
+		conf := &config{}
+		require.NoError(t, protojson.Unmarshal([]byte(`{"nonceHeader":"x-shared-nonce","localReplayCache":{}}`), conf))
+		require.NoError(t, conf.Init(nil))
+		return conf
+	}
+
+	conf := newConf()
+	added, _ := conf.replayCache.Add(context.Background(), "ak:n1", time.Minute)
+	assert.True(t, added)
+
+	// the recorded nonces are kept when the configuration is changed
+	conf = newConf()
+	added, _ = conf.replayCache.Add(context.Background(), "ak:n1", time.Minute)
+	assert.False(t, added)
+}
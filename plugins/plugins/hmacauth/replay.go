@@ -0,0 +1,110 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hmacauth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/redis/go-redis/v9"
+
+	"mosn.io/htnn/types/plugins/hmacauth"
+)
+
+const (
+	defaultReplayCacheCapacity = 100000
+	defaultReplayCachePrefix   = "htnn:hmac_auth:nonce:"
+)
+
+// replayCache records the used nonces
+type replayCache interface {
+	// Add records the key for the ttl. It returns false if the key is already recorded.
+	Add(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+type localReplayCache struct {
+	cache *ttlcache.Cache[string, struct{}]
+}
+
+// The local replay caches are shared among the configurations, so that the recorded nonces
+// are kept when the configuration is changed. Otherwise, the replay window is reopened.
+var localReplayCaches sync.Map
+
+func getLocalReplayCache(nonceHeader string, capacity uint32) *localReplayCache {
+	if capacity == 0 {
+		capacity = defaultReplayCacheCapacity
+	}
+	key := fmt.Sprintf("%s|%d", nonceHeader, capacity)
+	if c, ok := localReplayCaches.Load(key); ok {
+		return c.(*localReplayCache)
+	}
+	c, _ := localReplayCaches.LoadOrStore(key, newLocalReplayCache(capacity))
+	return c.(*localReplayCache)
+}
+
+func newLocalReplayCache(capacity uint32) *localReplayCache {
+	// The expired items are dropped when they are accessed or evicted due to the capacity,
+	// so we don't need to start the cleaner goroutine for each configuration.
+	return &localReplayCache{
+		cache: ttlcache.New(
+			ttlcache.WithCapacity[string, struct{}](uint64(capacity)),
+			ttlcache.WithDisableTouchOnHit[string, struct{}](),
+		),
+	}
+}
+
+func (c *localReplayCache) Add(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	_, found := c.cache.GetOrSet(key, struct{}{}, ttlcache.WithTTL[string, struct{}](ttl))
+	return !found, nil
+}
+
+type redisReplayCache struct {
+	client *redis.Client
+	prefix string
+}
+
+func newRedisReplayCache(conf *hmacauth.RedisReplayCache) *redisReplayCache {
+	opt := &redis.Options{
+		Addr:     conf.Address,
+		Username: conf.Username,
+		Password: conf.Password,
+	}
+	if conf.Tls {
+		opt.TLSConfig = &tls.Config{
+			InsecureSkipVerify: conf.TlsSkipVerify, // #nosec G402 -- configured by the user
+		}
+	}
+
+	prefix := conf.Prefix
+	if prefix == "" {
+		prefix = defaultReplayCachePrefix
+	}
+	return &redisReplayCache{
+		client: redis.NewClient(opt),
+		prefix: prefix,
+	}
+}
+
+func (c *redisReplayCache) Close() error {
+	return c.client.Close()
+}
+
+func (c *redisReplayCache) Add(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, c.prefix+key, 1, ttl).Result()
+}
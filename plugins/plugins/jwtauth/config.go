@@ -0,0 +1,97 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+func init() {
+	plugins.RegisterPlugin(jwtauth.Name, &plugin{})
+}
+
+type plugin struct {
+	jwtauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+const (
+	defaultConsumerClaim = "sub"
+	defaultClockSkew     = 60 * time.Second
+)
+
+type config struct {
+	jwtauth.CustomConfig
+
+	fromHeaders   []*jwtauth.JwtHeader
+	consumerClaim string
+	clockSkew     time.Duration
+	localJwks     *jose.JSONWebKeySet
+	remoteJwks    *remoteJwks
+}
+
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	conf.fromHeaders = conf.FromHeaders
+	if len(conf.fromHeaders) == 0 && len(conf.FromParams) == 0 {
+		conf.fromHeaders = []*jwtauth.JwtHeader{
+			{
+				Name:        "authorization",
+				ValuePrefix: "Bearer ",
+			},
+		}
+	}
+
+	conf.consumerClaim = conf.ConsumerClaim
+	if conf.consumerClaim == "" {
+		conf.consumerClaim = defaultConsumerClaim
+	}
+
+	conf.clockSkew = defaultClockSkew
+	if conf.ClockSkew != nil {
+		conf.clockSkew = conf.ClockSkew.AsDuration()
+	}
+
+	if local := conf.GetLocalJwks(); local != "" {
+		jwks := &jose.JSONWebKeySet{}
+		if err := json.Unmarshal([]byte(local), jwks); err != nil {
+			return err
+		}
+		conf.localJwks = jwks
+		return nil
+	}
+
+	jwks, err := getRemoteJwks(conf.GetRemoteJwks())
+	if err != nil {
+		return err
+	}
+	conf.remoteJwks = jwks
+	// warm up the cache, so that the first request doesn't need to wait for the fetching
+	go jwks.refresh()
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "jwks is required",
+			input: `{}`,
+			err:   "invalid Config.JwksSource: value is required",
+		},
+		{
+			name:  "invalid local jwks",
+			input: `{"localJwks": "{"}`,
+			err:   "invalid local_jwks",
+		},
+		{
+			name:  "empty local jwks",
+			input: `{"localJwks": ""}`,
+			err:   "invalid local_jwks",
+		},
+		{
+			name:  "local jwks without key",
+			input: `{"localJwks": "{\"keys\":[]}"}`,
+			err:   "invalid local_jwks: no key",
+		},
+		{
+			name:  "invalid remote jwks",
+			input: `{"remoteJwks": {"url": "/jwks"}}`,
+			err:   "invalid RemoteJwks.Url",
+		},
+		{
+			name:  "invalid header",
+			input: `{"remoteJwks": {"url": "http://example.com/jwks"}, "fromHeaders": [{"valuePrefix": "Bearer "}]}`,
+			err:   "invalid JwtHeader.Name",
+		},
+		{
+			name:  "remote jwks",
+			input: `{"remoteJwks": {"url": "http://example.com/jwks", "timeout": "1s", "cacheDuration": "3600s"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
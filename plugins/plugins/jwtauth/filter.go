@@ -0,0 +1,203 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+	jose.HS256, jose.HS384, jose.HS512,
+}
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+}
+
+// extractToken returns the token and removes it from the request unless it's configured to forward.
+// Return an error if multiple tokens are found.
+func (f *filter) extractToken(headers api.RequestHeaderMap) (string, error) {
+	config := f.config
+	for _, h := range config.fromHeaders {
+		vals := headers.Values(h.Name)
+		var tokens []string
+		for _, v := range vals {
+			if token, ok := strings.CutPrefix(v, h.ValuePrefix); ok && token != "" {
+				tokens = append(tokens, strings.TrimSpace(token))
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		if len(tokens) > 1 {
+			return "", errors.New("duplicate token found")
+		}
+		if !config.Forward {
+			headers.Del(h.Name)
+		}
+		return tokens[0], nil
+	}
+
+	if len(config.FromParams) == 0 {
+		return "", nil
+	}
+	u := headers.URL()
+	query := u.Query()
+	for _, name := range config.FromParams {
+		vals := query[name]
+		if len(vals) == 0 {
+			continue
+		}
+		if len(vals) > 1 {
+			return "", errors.New("duplicate token found")
+		}
+		if !config.Forward {
+			query.Del(name)
+			u.RawQuery = query.Encode()
+			headers.Set(":path", u.String())
+		}
+		return vals[0], nil
+	}
+	return "", nil
+}
+
+func keysForToken(jwks *jose.JSONWebKeySet, token *jwt.JSONWebToken) []jose.JSONWebKey {
+	kid := token.Headers[0].KeyID
+	if kid == "" {
+		return jwks.Keys
+	}
+	return jwks.Key(kid)
+}
+
+func (f *filter) verifySignature(ctx context.Context, token *jwt.JSONWebToken) (map[string]interface{}, error) {
+	config := f.config
+	jwks := config.localJwks
+	if jwks == nil {
+		var err error
+		jwks, err = config.remoteJwks.Keys(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get JWKS: %w", err)
+		}
+	}
+
+	keys := keysForToken(jwks, token)
+	if len(keys) == 0 && config.remoteJwks != nil {
+		if refetched := config.remoteJwks.KeysForUnknownKid(ctx); refetched != nil {
+			keys = keysForToken(refetched, token)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no matched key")
+	}
+
+	alg := token.Headers[0].Algorithm
+	for _, key := range keys {
+		if key.Algorithm != "" && key.Algorithm != alg {
+			continue
+		}
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		claims := map[string]interface{}{}
+		if err := token.Claims(key.Key, &claims); err == nil {
+			return claims, nil
+		}
+	}
+	return nil, errors.New("signature mismatch")
+}
+
+func (f *filter) verify(ctx context.Context, raw string) (map[string]interface{}, error) {
+	token, err := jwt.ParseSigned(raw, supportedAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := f.verifySignature(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature is verified, now we can check the registered claims
+	var registered jwt.Claims
+	if err := token.UnsafeClaimsWithoutVerification(&registered); err != nil {
+		return nil, err
+	}
+	config := f.config
+	expected := jwt.Expected{
+		Issuer:      config.Issuer,
+		AnyAudience: config.Audiences,
+		Time:        time.Now(),
+	}
+	if err := registered.ValidateWithLeeway(expected, config.clockSkew); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	raw, err := f.extractToken(headers)
+	if err != nil {
+		return &api.LocalResponse{Code: 401, Msg: err.Error()}
+	}
+	if raw == "" {
+		return api.Continue
+	}
+
+	claims, err := f.verify(f.callbacks.Context(), raw)
+	if err != nil {
+		api.LogInfof("invalid token: %v", err)
+		return &api.LocalResponse{Code: 401, Msg: "invalid token"}
+	}
+
+	claim := f.config.consumerClaim
+	value, ok := claims[claim].(string)
+	if !ok || value == "" {
+		api.LogInfof("claim %s is missing or not a string", claim)
+		return &api.LocalResponse{Code: 401, Msg: "invalid token"}
+	}
+
+	c, ok := f.callbacks.LookupConsumer(jwtauth.Name, value)
+	if !ok {
+		api.LogInfof("can not find consumer with %s %s", claim, value)
+		return &api.LocalResponse{Code: 401, Msg: "invalid token"}
+	}
+
+	f.callbacks.SetConsumer(c)
+	return api.Continue
+}
@@ -0,0 +1,413 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+type testKey struct {
+	alg     jose.SignatureAlgorithm
+	private interface{}
+	public  jose.JSONWebKey
+}
+
+func newTestKeys(t *testing.T) map[string]*testKey {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	secret := []byte("a-secret-which-is-long-enough-for-hs256")
+
+	return map[string]*testKey{
+		"rsa": {
+			alg:     jose.RS256,
+			private: rsaKey,
+			public:  jose.JSONWebKey{Key: rsaKey.Public(), KeyID: "rsa", Algorithm: "RS256", Use: "sig"},
+		},
+		"ec": {
+			alg:     jose.ES256,
+			private: ecKey,
+			public:  jose.JSONWebKey{Key: ecKey.Public(), KeyID: "ec"},
+		},
+		"ed": {
+			alg:     jose.EdDSA,
+			private: edKey,
+			public:  jose.JSONWebKey{Key: edPub, KeyID: "ed"},
+		},
+		"hs": {
+			alg:     jose.HS256,
+			private: secret,
+			public:  jose.JSONWebKey{Key: secret, KeyID: "hs"},
+		},
+	}
+}
+
+func jwksJSON(t *testing.T, keys ...jose.JSONWebKey) string {
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+	require.NoError(t, err)
+	return string(data)
+}
+
+func sign(t *testing.T, key *testKey, kid string, claims interface{}) string {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if kid != "" {
+		opts = opts.WithHeader(jose.HeaderKey("kid"), kid)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: key.alg, Key: key.private}, opts)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func newConfig(t *testing.T, input string) *config {
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+	return conf
+}
+
+func TestJwtAuth(t *testing.T) {
+	keys := newTestKeys(t)
+	var publicKeys []jose.JSONWebKey
+	for _, k := range keys {
+		publicKeys = append(publicKeys, k.public)
+	}
+	localJwks, _ := json.Marshal(jwksJSON(t, publicKeys...))
+	defaultConf := `{"localJwks":` + string(localJwks) + `, "issuer": "htnn", "audiences": ["a", "b"]}`
+
+	now := time.Now()
+	validClaims := jwt.Claims{
+		Subject:  "user",
+		Issuer:   "htnn",
+		Audience: jwt.Audience{"b"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		jwtauth.Name: &jwtauth.ConsumerConfig{ClaimValue: "user"},
+	})
+
+	tests := []struct {
+		name     string
+		conf     string
+		hdr      http.Header
+		path     string
+		status   int
+		consumer bool
+		check    func(t *testing.T, hdr api.RequestHeaderMap)
+	}{
+		{
+			name:     "rsa",
+			hdr:      http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", validClaims)}},
+			consumer: true,
+			check: func(t *testing.T, hdr api.RequestHeaderMap) {
+				_, ok := hdr.Get("authorization")
+				assert.False(t, ok)
+			},
+		},
+		{
+			name:     "ec",
+			hdr:      http.Header{"Authorization": {"Bearer " + sign(t, keys["ec"], "ec", validClaims)}},
+			consumer: true,
+		},
+		{
+			name:     "eddsa without kid",
+			hdr:      http.Header{"Authorization": {"Bearer " + sign(t, keys["ed"], "", validClaims)}},
+			consumer: true,
+		},
+		{
+			name:     "hs",
+			hdr:      http.Header{"Authorization": {"Bearer " + sign(t, keys["hs"], "hs", validClaims)}},
+			consumer: true,
+		},
+		{
+			name: "no token",
+			hdr:  http.Header{"Authorization": {"Basic xxx"}},
+		},
+		{
+			name: "duplicate token",
+			hdr: http.Header{"Authorization": {
+				"Bearer " + sign(t, keys["rsa"], "rsa", validClaims),
+				"Bearer " + sign(t, keys["ec"], "ec", validClaims),
+			}},
+			status: 401,
+		},
+		{
+			name:   "malformed token",
+			hdr:    http.Header{"Authorization": {"Bearer xxx"}},
+			status: 401,
+		},
+		{
+			name:   "unknown kid",
+			hdr:    http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "unknown", validClaims)}},
+			status: 401,
+		},
+		{
+			name:   "signed by other key",
+			hdr:    http.Header{"Authorization": {"Bearer " + sign(t, keys["ec"], "rsa", validClaims)}},
+			status: 401,
+		},
+		{
+			name: "expired",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:  "user",
+				Issuer:   "htnn",
+				Audience: jwt.Audience{"a"},
+				Expiry:   jwt.NewNumericDate(now.Add(-2 * time.Minute)),
+			})}},
+			status: 401,
+		},
+		{
+			name: "expired but within the clock skew",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:  "user",
+				Issuer:   "htnn",
+				Audience: jwt.Audience{"a"},
+				Expiry:   jwt.NewNumericDate(now.Add(-30 * time.Second)),
+			})}},
+			consumer: true,
+		},
+		{
+			name: "not before",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:   "user",
+				Issuer:    "htnn",
+				Audience:  jwt.Audience{"a"},
+				NotBefore: jwt.NewNumericDate(now.Add(time.Hour)),
+			})}},
+			status: 401,
+		},
+		{
+			name: "wrong issuer",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:  "user",
+				Issuer:   "other",
+				Audience: jwt.Audience{"a"},
+			})}},
+			status: 401,
+		},
+		{
+			name: "wrong audience",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:  "user",
+				Issuer:   "htnn",
+				Audience: jwt.Audience{"c"},
+			})}},
+			status: 401,
+		},
+		{
+			name: "consumer not found",
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{
+				Subject:  "other",
+				Issuer:   "htnn",
+				Audience: jwt.Audience{"a"},
+			})}},
+			status: 401,
+		},
+		{
+			name: "custom claim, token from query and forwarded",
+			conf: `{"localJwks":` + string(localJwks) + `, "fromParams": ["token"], "consumerClaim": "client_id", "forward": true}`,
+			path: "/echo?token=" + sign(t, keys["ec"], "ec", map[string]interface{}{
+				"client_id": "user",
+			}),
+			consumer: true,
+			check: func(t *testing.T, hdr api.RequestHeaderMap) {
+				assert.Contains(t, hdr.Path(), "token=")
+			},
+		},
+		{
+			name:     "token from query",
+			conf:     `{"localJwks":` + string(localJwks) + `, "fromParams": ["token"]}`,
+			path:     "/echo?token=" + sign(t, keys["ec"], "ec", validClaims) + "&a=1",
+			consumer: true,
+			check: func(t *testing.T, hdr api.RequestHeaderMap) {
+				assert.Equal(t, "/echo?a=1", hdr.Path())
+			},
+		},
+		{
+			name: "claim is not a string",
+			conf: `{"localJwks":` + string(localJwks) + `, "consumerClaim": "id"}`,
+			hdr: http.Header{"Authorization": {"Bearer " + sign(t, keys["ec"], "ec", map[string]interface{}{
+				"id": 1,
+			})}},
+			status: 401,
+		},
+		{
+			name: "custom header",
+			conf: `{"localJwks":` + string(localJwks) + `, "fromHeaders": [{"name": "x-jwt"}]}`,
+			hdr: http.Header{
+				"X-Jwt": {sign(t, keys["ec"], "ec", validClaims)},
+			},
+			consumer: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			input := tt.conf
+			if input == "" {
+				input = defaultConf
+			}
+			f := factory(newConfig(t, input), cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(pluginName, key string) (api.Consumer, bool) {
+				if pluginName == jwtauth.Name && key == "user" {
+					return c, true
+				}
+				return nil, false
+			})
+			defer patches.Reset()
+
+			path := tt.path
+			if path == "" {
+				path = "/echo"
+			}
+			httpHdr := http.Header{
+				":authority": {"test.local"},
+				":method":    {"GET"},
+				":path":      {path},
+			}
+			for k, v := range tt.hdr {
+				httpHdr[k] = v
+			}
+			hdr := envoy.NewRequestHeaderMap(httpHdr)
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+			} else {
+				assert.Equal(t, api.Continue, res)
+			}
+			if tt.consumer {
+				assert.Equal(t, c, cb.GetConsumer())
+			} else {
+				assert.Nil(t, cb.GetConsumer())
+			}
+			if tt.check != nil {
+				tt.check(t, hdr)
+			}
+		})
+	}
+}
+
+func expire(r *remoteJwks, d time.Duration) {
+	r.fetchLock.Lock()
+	r.lastFetch = time.Now().Add(-d)
+	r.cache.Store(&cachedJwks{keys: r.cache.Load().keys, fetchedAt: time.Now().Add(-d)})
+	r.fetchLock.Unlock()
+}
+
+func TestRemoteJwks(t *testing.T) {
+	keys := newTestKeys(t)
+	var jwks atomic.Value
+	jwks.Store(jwksJSON(t, keys["rsa"].public))
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.Write([]byte(jwks.Load().(string)))
+	}))
+	defer srv.Close()
+
+	conf := newConfig(t, `{"remoteJwks": {"url": "`+srv.URL+`"}}`)
+	c := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		jwtauth.Name: &jwtauth.ConsumerConfig{ClaimValue: "user"},
+	})
+	verify := func(key string) api.ResultAction {
+		cb := envoy.NewFilterCallbackHandler()
+		patches := gomonkey.ApplyMethodReturn(cb, "LookupConsumer", c, true)
+		defer patches.Reset()
+		f := factory(conf, cb)
+		hdr := envoy.NewRequestHeaderMap(http.Header{
+			":path":         {"/"},
+			"Authorization": {"Bearer " + sign(t, keys[key], key, jwt.Claims{Subject: "user"})},
+		})
+		return f.DecodeHeaders(hdr, true)
+	}
+
+	assert.Equal(t, api.Continue, verify("rsa"))
+	assert.Equal(t, api.Continue, verify("rsa"))
+	assert.Equal(t, int32(1), count.Load())
+
+	// the key is rotated, but the JWKS is fetched just now
+	jwks.Store(jwksJSON(t, keys["ec"].public))
+	res := verify("ec")
+	assert.Equal(t, 401, res.(*api.LocalResponse).Code)
+
+	// the JWKS is refetched for the unknown kid
+	expire(conf.remoteJwks, time.Minute)
+	assert.Equal(t, api.Continue, verify("ec"))
+	assert.Equal(t, int32(2), count.Load())
+
+	// the expired JWKS is refreshed in background
+	jwks.Store(jwksJSON(t, keys["ed"].public))
+	expire(conf.remoteJwks, time.Hour)
+	assert.Equal(t, api.Continue, verify("ec"))
+	assert.Eventually(t, func() bool {
+		return count.Load() == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return verify("ed") == api.Continue
+	}, time.Second, 10*time.Millisecond)
+
+	// the JWKS is shared
+	conf2 := newConfig(t, `{"remoteJwks": {"url": "`+srv.URL+`"}}`)
+	assert.Same(t, conf.remoteJwks, conf2.remoteJwks)
+}
+
+func TestRemoteJwksUnavailable(t *testing.T) {
+	keys := newTestKeys(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	conf := newConfig(t, `{"remoteJwks": {"url": "`+srv.URL+`/unavailable"}}`)
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(conf, cb)
+	hdr := envoy.NewRequestHeaderMap(http.Header{
+		":path":         {"/"},
+		"Authorization": {"Bearer " + sign(t, keys["rsa"], "rsa", jwt.Claims{Subject: "user"})},
+	})
+	res := f.DecodeHeaders(hdr, true)
+	assert.Equal(t, 401, res.(*api.LocalResponse).Code)
+}
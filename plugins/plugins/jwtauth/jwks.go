@@ -0,0 +1,199 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/request"
+	"mosn.io/htnn/types/plugins/jwtauth"
+)
+
+const (
+	defaultJwksTimeout       = 3 * time.Second
+	defaultJwksCacheDuration = 10 * time.Minute
+	// minJwksRefreshInterval limits the refresh triggered by the unknown key id,
+	// so that the JWKS server won't be flooded by the forged tokens.
+	minJwksRefreshInterval = 30 * time.Second
+	// jwksFetchRetryInterval avoids fetching again and again when the JWKS server is down
+	jwksFetchRetryInterval = time.Second
+)
+
+type cachedJwks struct {
+	keys      *jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// remoteJwks caches the JWKS fetched from the remote. The cache is refreshed in background
+// when it's expired, and the requests use the stale keys in the meantime.
+type remoteJwks struct {
+	url           string
+	client        *http.Client
+	cacheDuration time.Duration
+
+	cache      atomic.Pointer[cachedJwks]
+	refreshing atomic.Bool
+	fetchLock  sync.Mutex
+	// lastFetch and lastErr are protected by fetchLock
+	lastFetch time.Time
+	lastErr   error
+}
+
+// The JWKS is shared among the configurations, so that the cache is kept when the configuration
+// is changed.
+var remoteJwksCache sync.Map
+
+func getRemoteJwks(conf *jwtauth.RemoteJwks) (*remoteJwks, error) {
+	timeout := defaultJwksTimeout
+	if conf.Timeout != nil {
+		timeout = conf.Timeout.AsDuration()
+	}
+	cacheDuration := defaultJwksCacheDuration
+	if conf.CacheDuration != nil {
+		cacheDuration = conf.CacheDuration.AsDuration()
+	}
+
+	key := fmt.Sprintf("%s|%s|%s|%s|%s|%s", conf.Url, timeout, cacheDuration, conf.Tls.String(), conf.Retry.String(),
+		conf.CircuitBreaker.String())
+	if v, ok := remoteJwksCache.Load(key); ok {
+		return v.(*remoteJwks), nil
+	}
+
+	client, err := request.NewClient(request.ClientOptions{
+		Name:           jwtauth.Name,
+		Timeout:        timeout,
+		TLS:            request.NewTLSOptions(conf.Tls),
+		Retry:          request.NewRetryPolicy(conf.Retry),
+		CircuitBreaker: request.NewCircuitBreakerPolicy(conf.CircuitBreaker),
+	})
+	if err != nil {
+		return nil, err
+	}
+	v, _ := remoteJwksCache.LoadOrStore(key, &remoteJwks{
+		url:           conf.Url,
+		client:        client,
+		cacheDuration: cacheDuration,
+	})
+	return v.(*remoteJwks), nil
+}
+
+func (r *remoteJwks) fetch(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d, body: %s", resp.StatusCode, body)
+	}
+
+	jwks := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(body, jwks); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	return jwks, nil
+}
+
+// update fetches the JWKS and updates the cache. Only one fetching is allowed at the same time.
+// If the cache is updated by others after the given time, the fetching is skipped.
+func (r *remoteJwks) update(ctx context.Context, after time.Time) (*jose.JSONWebKeySet, error) {
+	r.fetchLock.Lock()
+	defer r.fetchLock.Unlock()
+
+	if c := r.cache.Load(); c != nil && c.fetchedAt.After(after) {
+		return c.keys, nil
+	}
+
+	if r.lastErr != nil && time.Since(r.lastFetch) < jwksFetchRetryInterval {
+		return nil, r.lastErr
+	}
+
+	r.lastFetch = time.Now()
+	jwks, err := r.fetch(ctx)
+	r.lastErr = err
+	if err != nil {
+		api.LogErrorf("failed to fetch JWKS from %s: %v", r.url, err)
+		return nil, err
+	}
+	r.cache.Store(&cachedJwks{keys: jwks, fetchedAt: time.Now()})
+	return jwks, nil
+}
+
+func (r *remoteJwks) refresh() {
+	if !r.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	defer r.refreshing.Store(false)
+
+	start := time.Now()
+	_, _ = r.update(context.Background(), start)
+}
+
+// Keys returns the cached JWKS. It fetches the JWKS if there is no cache.
+func (r *remoteJwks) Keys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	c := r.cache.Load()
+	if c == nil {
+		return r.update(ctx, time.Time{})
+	}
+	if time.Since(c.fetchedAt) > r.cacheDuration {
+		go r.refresh()
+	}
+	return c.keys, nil
+}
+
+// KeysForUnknownKid refetches the JWKS when the key id is not found, as the keys may be rotated.
+// Return nil if the JWKS is not refetched.
+func (r *remoteJwks) KeysForUnknownKid(ctx context.Context) *jose.JSONWebKeySet {
+	c := r.cache.Load()
+	if c != nil && time.Since(c.fetchedAt) < minJwksRefreshInterval {
+		return nil
+	}
+
+	r.fetchLock.Lock()
+	recentlyFetched := time.Since(r.lastFetch) < minJwksRefreshInterval
+	r.fetchLock.Unlock()
+	if recentlyFetched {
+		return nil
+	}
+
+	var after time.Time
+	if c != nil {
+		after = c.fetchedAt
+	}
+	jwks, err := r.update(ctx, after)
+	if err != nil {
+		return nil
+	}
+	return jwks
+}
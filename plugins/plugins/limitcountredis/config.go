@@ -15,8 +15,10 @@
 package limitcountredis
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"runtime"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -62,13 +64,19 @@ type config struct {
 
 	limiters    []*Limiter
 	quotaPolicy string
+
+	health *health
 }
 
 type Limiter struct {
 	script     expr.Script
+	cost       expr.Script
 	count      uint32
 	timeWindow int64
+	algorithm  limitcountredis.Rule_Algorithm
 	prefix     string
+
+	local *localLimiter
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
@@ -104,6 +112,10 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	}
 
 	prefix := conf.Prefix
+	if conf.GetCluster() != nil && conf.Hierarchical {
+		// The keys checked in one script must be in the same slot
+		prefix = "{" + prefix + "}"
+	}
 	api.LogInfof("limitCountRedis filter uses %s as prefix, config: %v", prefix, &conf.Config)
 
 	conf.limiters = make([]*Limiter, len(conf.Rules))
@@ -112,17 +124,50 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		conf.limiters[i] = &Limiter{
 			count:      rule.Count,
 			timeWindow: rule.TimeWindow.Seconds,
+			algorithm:  rule.Algorithm,
 			prefix:     fmt.Sprintf("%s|%d", prefix, i),
 		}
+		if rule.Algorithm != limitcountredis.Rule_FIXED_WINDOW {
+			// different algorithms use different data types
+			conf.limiters[i].prefix += "|" + strings.ToLower(rule.Algorithm.String())
+		}
 		quotaPolicy[i] = fmt.Sprintf("%d;w=%d", rule.Count, rule.TimeWindow.Seconds)
 
-		if rule.Key == "" {
-			continue
+		if rule.Key != "" {
+			script, _ := expr.CompileCel(rule.Key, cel.StringType)
+			conf.limiters[i].script = script
+		}
+		if rule.Cost != "" {
+			script, _ := expr.CompileCel(rule.Cost, cel.IntType)
+			conf.limiters[i].cost = script
 		}
-		script, _ := expr.CompileCel(rule.Key, cel.StringType)
-		conf.limiters[i].script = script
 	}
 	conf.quotaPolicy = strings.Join(quotaPolicy, ", ")
 
+	if fallback := conf.LocalFallback; fallback != nil {
+		for _, limiter := range conf.limiters {
+			limiter.local = newLocalLimiter(limiter.count, limiter.timeWindow, fallback.ExpectedReplicas)
+		}
+
+		var ping func(ctx context.Context) error
+		if conf.client != nil {
+			client := conf.client
+			ping = func(ctx context.Context) error {
+				return client.Ping(ctx).Err()
+			}
+		} else {
+			client := conf.clusterClient
+			ping = func(ctx context.Context) error {
+				return client.ForEachShard(ctx, func(ctx context.Context, shard *redis.Client) error {
+					return shard.Ping(ctx).Err()
+				})
+			}
+		}
+		conf.health = newHealth(fallback, ping)
+		runtime.SetFinalizer(conf, func(conf *config) {
+			close(conf.health.stop)
+		})
+	}
+
 	return nil
 }
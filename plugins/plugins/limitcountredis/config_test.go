@@ -66,6 +66,47 @@ func TestConfig(t *testing.T) {
 			name:  "pass",
 			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test"}`,
 		},
+		{
+			name:  "algorithm",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s","algorithm":"SLIDING_WINDOW_COUNTER"}], "prefix":"test"}`,
+		},
+		{
+			name:  "unknown algorithm",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s","algorithm":"LEAKY_BUCKET"}], "prefix":"test"}`,
+			err:   "invalid value for enum",
+		},
+		{
+			name:  "local fallback",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test", "localFallback":{"expectedReplicas":3}}`,
+		},
+		{
+			name:  "expected replicas is required",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test", "localFallback":{}}`,
+			err:   "invalid LocalFallback.ExpectedReplicas",
+		},
+		{
+			name:  "local fallback conflicts with failure mode deny",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test", "failureModeDeny":true, "localFallback":{"expectedReplicas":3}}`,
+			err:   "failureModeDeny and localFallback can't be configured together",
+		},
+		{
+			name:  "cost",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":10,"timeWindow":"1s","cost":"int(request.header('x-cost'))"}], "prefix":"test", "hierarchical":true}`,
+		},
+		{
+			name:  "cost should return int",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":10,"timeWindow":"1s","cost":"request.header('x-cost')"}], "prefix":"test"}`,
+			err:   "bad rule 0",
+		},
+		{
+			name:  "cost is not supported by sliding window log",
+			input: `{"address":"127.0.0.1:6479", "rules":[{"count":10,"timeWindow":"1s","cost":"2","algorithm":"SLIDING_WINDOW_LOG"}], "prefix":"test"}`,
+			err:   "cost is not supported by the SLIDING_WINDOW_LOG algorithm",
+		},
+		{
+			name:  "hierarchical with cluster",
+			input: `{"cluster":{"addresses":["127.0.0.1:6479"]}, "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test", "hierarchical":true}`,
+		},
 		{
 			name:  "disable x-envoy-ratelimited header",
 			input: `{"address":"127.0.0.1:6479", "rules":[{"count":1,"timeWindow":"1s"}], "prefix":"test", "disable_x_envoy_ratelimited_header": true}`,
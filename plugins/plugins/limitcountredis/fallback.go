@@ -0,0 +1,155 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limitcountredis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/redis/go-redis/v9"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/limitcountredis"
+)
+
+var (
+	fallbackTransitions = api.DefineCounter("htnn.limit_count_redis.fallback.transitions")
+	// the number of configurations which are limiting locally
+	fallbackActive = api.DefineGauge("htnn.limit_count_redis.fallback.active")
+)
+
+const (
+	defaultProbeInterval = 5 * time.Second
+	probeTimeout         = 1 * time.Second
+	localCacheCapacity   = 100000
+)
+
+// isUnreachable reports whether the error means Redis can't be reached. An error replied by
+// Redis, like a script error, doesn't count.
+func isUnreachable(err error) bool {
+	var redisErr redis.Error
+	return !errors.As(err, &redisErr)
+}
+
+// localLimiter is an approximate fixed window limiter in the process. It's used when Redis is
+// unreachable.
+type localLimiter struct {
+	count      int64
+	timeWindow time.Duration
+	counters   *ttlcache.Cache[string, *atomic.Int64]
+}
+
+func newLocalLimiter(count uint32, timeWindow int64, replicas uint32) *localLimiter {
+	local := int64(count) / int64(replicas)
+	if local < 1 {
+		local = 1
+	}
+	window := time.Duration(timeWindow) * time.Second
+	return &localLimiter{
+		count:      local,
+		timeWindow: window,
+		counters: ttlcache.New(
+			ttlcache.WithTTL[string, *atomic.Int64](window),
+			ttlcache.WithCapacity[string, *atomic.Int64](localCacheCapacity),
+			ttlcache.WithDisableTouchOnHit[string, *atomic.Int64](),
+		),
+	}
+}
+
+// limit returns the remaining count and the seconds to reset, like the Redis script.
+func (l *localLimiter) limit(key string, cost int64) (int64, int64) {
+	item, _ := l.counters.GetOrSet(key, &atomic.Int64{})
+	used := item.Value().Add(cost)
+	reset := int64(math.Ceil(time.Until(item.ExpiresAt()).Seconds()))
+	return l.count - used, reset
+}
+
+// refund gives back the cost consumed by a request which is rejected by other rules.
+func (l *localLimiter) refund(key string, cost int64) {
+	item := l.counters.Get(key)
+	if item != nil {
+		item.Value().Add(-cost)
+	}
+}
+
+// health tracks whether Redis is reachable. It doesn't reference the config, so the config can
+// be garbage collected while probing.
+type health struct {
+	unavailable atomic.Bool
+	interval    time.Duration
+	ping        func(ctx context.Context) error
+	stop        chan struct{}
+}
+
+func newHealth(fallback *limitcountredis.LocalFallback, ping func(ctx context.Context) error) *health {
+	interval := defaultProbeInterval
+	if fallback.ProbeInterval != nil {
+		interval = fallback.ProbeInterval.AsDuration()
+	}
+	return &health{
+		interval: interval,
+		ping:     ping,
+		stop:     make(chan struct{}),
+	}
+}
+
+func (h *health) available() bool {
+	return !h.unavailable.Load()
+}
+
+// markUnavailable switches to the local rate limiting and probes Redis until it's back.
+func (h *health) markUnavailable(err error) {
+	if !h.unavailable.CompareAndSwap(false, true) {
+		return
+	}
+
+	api.LogWarnf("limitCountRedis filter falls back to local rate limiting as Redis is unreachable: %v", err)
+	fallbackTransitions.WithTags(api.MetricTag{Key: "to", Value: "local"}).Increment(1)
+	fallbackActive.Increment(1)
+
+	go h.probe()
+}
+
+func (h *health) probe() {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			fallbackActive.Increment(-1)
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+		err := h.ping(ctx)
+		cancel()
+		if err != nil {
+			api.LogInfof("limitCountRedis filter failed to probe Redis: %v", err)
+			continue
+		}
+
+		api.LogWarnf("limitCountRedis filter switches back to Redis as it's reachable again")
+		fallbackTransitions.WithTags(api.MetricTag{Key: "to", Value: "redis"}).Increment(1)
+		fallbackActive.Increment(-1)
+		h.unavailable.Store(false)
+		return
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limitcountredis
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+)
+
+func TestLocalLimiter(t *testing.T) {
+	l := newLocalLimiter(5, 10, 2)
+	remain, reset := l.limit("a", 1)
+	assert.Equal(t, int64(1), remain)
+	assert.Equal(t, int64(10), reset)
+	remain, _ = l.limit("a", 1)
+	assert.Equal(t, int64(0), remain)
+	remain, _ = l.limit("a", 1)
+	assert.Equal(t, int64(-1), remain)
+	remain, _ = l.limit("b", 1)
+	assert.Equal(t, int64(1), remain)
+	remain, _ = l.limit("b", 2)
+	assert.Equal(t, int64(-1), remain)
+	l.refund("b", 2)
+	remain, _ = l.limit("b", 1)
+	assert.Equal(t, int64(0), remain)
+
+	// at least one request is allowed
+	l = newLocalLimiter(1, 10, 3)
+	remain, _ = l.limit("a", 1)
+	assert.Equal(t, int64(0), remain)
+}
+
+func transitions(to string) uint64 {
+	return fallbackTransitions.WithTags(api.MetricTag{Key: "to", Value: to}).Get()
+}
+
+func TestLocalFallback(t *testing.T) {
+	s := miniredis.RunT(t)
+	conf := &config{}
+	input := `{"address":"` + s.Addr() + `", "prefix":"test", "enableLimitQuotaHeaders": true,
+		"rules":[{"count":4,"timeWindow":"10s"}], "localFallback": {"expectedReplicas": 2, "probeInterval": "0.05s"}}`
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	decode := func() (api.ResultAction, string) {
+		f := factory(conf, envoy.NewFilterCallbackHandler())
+		res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+		hdr := envoy.NewResponseHeaderMap(http.Header{})
+		f.EncodeHeaders(hdr, true)
+		remain, _ := hdr.Get("x-ratelimit-remaining")
+		return res, remain
+	}
+
+	res, remain := decode()
+	assert.Equal(t, api.Continue, res)
+	assert.Equal(t, "3", remain)
+
+	toLocal := transitions("local")
+	toRedis := transitions("redis")
+	active := fallbackActive.Get()
+	s.Close()
+
+	// the count is divided by the expected replicas
+	res, remain = decode()
+	assert.Equal(t, api.Continue, res)
+	assert.Equal(t, "1", remain)
+	assert.False(t, conf.health.available())
+	assert.Equal(t, toLocal+1, transitions("local"))
+	assert.Equal(t, active+1, fallbackActive.Get())
+	res, _ = decode()
+	assert.Equal(t, api.Continue, res)
+	res, _ = decode()
+	lr, ok := res.(*api.LocalResponse)
+	require.True(t, ok)
+	assert.Equal(t, 429, lr.Code)
+
+	require.NoError(t, s.Restart())
+	require.Eventually(t, func() bool {
+		return conf.health.available()
+	}, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, toRedis+1, transitions("redis"))
+	assert.Equal(t, active, fallbackActive.Get())
+
+	res, remain = decode()
+	assert.Equal(t, api.Continue, res)
+	assert.Equal(t, "2", remain)
+}
+
+func TestLocalFallbackIgnoreRedisError(t *testing.T) {
+	s := miniredis.RunT(t)
+	conf := &config{}
+	input := `{"address":"` + s.Addr() + `", "prefix":"test",
+		"rules":[{"count":4,"timeWindow":"10s","algorithm":"GCRA"}], "localFallback": {"expectedReplicas": 1}}`
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Init(nil))
+
+	// the key with a wrong type makes the script fail
+	_, err := s.Lpush(conf.limiters[0].prefix+"|183.128.130.43", "y")
+	require.NoError(t, err)
+
+	f := factory(conf, envoy.NewFilterCallbackHandler())
+	res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+	assert.Equal(t, api.Continue, res)
+	assert.True(t, conf.health.available())
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
 
@@ -26,6 +27,7 @@ import (
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/plugins/pkg/stringx"
 	"mosn.io/htnn/types/pkg/expr"
+	"mosn.io/htnn/types/plugins/limitcountredis"
 )
 
 func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
@@ -61,36 +63,128 @@ func (f *filter) getKey(script expr.Script, headers api.RequestHeaderMap) string
 	return key
 }
 
+func (f *filter) getCost(script expr.Script, headers api.RequestHeaderMap) int64 {
+	if script == nil {
+		return 1
+	}
+	res, err := script.EvalWithRequest(f.callbacks, headers)
+	if err != nil {
+		api.LogInfof("limitCountRedis filter uses 1 as cost because the cost is not evaluated: %v", err)
+		return 1
+	}
+	cost := res.(int64)
+	if cost < 0 {
+		api.LogInfof("limitCountRedis filter uses 1 as cost because the evaluated cost %d is negative", cost)
+		return 1
+	}
+	return cost
+}
+
 var (
+	// The script returns the remaining count and the seconds to reset for each rule.
+	// A negative remaining count means the request is rejected.
+	// All the algorithms except the fixed window use the time of Redis, so the gateways share
+	// the same clock.
+	// When ARGV[1] is '1', the rules are checked without consuming the quotas first, and the
+	// quotas are only consumed when all the rules allow the request.
 	redisScript = stringx.CutSpace(`
-	local res={}
-	for i=1,%d do
-		local ttl=redis.call('ttl',KEYS[i])
-		if ttl<0 then
-			redis.call('set',KEYS[i],ARGV[i*2-1]-1,'EX',ARGV[i*2])
-			res[i*2-1]=ARGV[i*2-1]-1
-			res[i*2]=tonumber(ARGV[i*2])
-		else
-			res[i*2-1]=redis.call('incrby',KEYS[i],-1)
-			res[i*2]=ttl
+	local function limit(key,count,window,algo,id,cost,dry)
+		if algo==0 then
+			local ttl=redis.call('ttl',key)
+			if ttl<0 then
+				if not dry then
+					redis.call('set',key,count-cost,'EX',window)
+				end
+				return {count-cost,window}
+			end
+			if dry then
+				return {tonumber(redis.call('get',key))-cost,ttl}
+			end
+			return {redis.call('incrby',key,-cost),ttl}
+		end
+
+		local t=redis.call('time')
+		local now=tonumber(t[1])*1000+math.floor(tonumber(t[2])/1000)
+		local win=window*1000
+		if algo==1 then
+			redis.call('zremrangebyscore',key,'-inf',now-win)
+			local remain=count-redis.call('zcard',key)-1
+			if remain>=0 and not dry then
+				redis.call('zadd',key,now,id)
+				redis.call('pexpire',key,win)
+			end
+			local reset=window
+			local oldest=redis.call('zrange',key,0,0,'withscores')
+			if #oldest>0 then
+				reset=math.ceil((tonumber(oldest[2])+win-now)/1000)
+			end
+			return {remain,reset}
+		end
+
+		if algo==2 then
+			local idx=math.floor(now/win)
+			local v=redis.call('hmget',key,'w','c','p')
+			local w=tonumber(v[1])
+			local cur=tonumber(v[2]) or 0
+			local prev=tonumber(v[3]) or 0
+			if w~=idx then
+				if w==idx-1 then
+					prev=cur
+				else
+					prev=0
+				end
+				cur=0
+			end
+			local used=prev*((idx+1)*win-now)/win+cur
+			local remain=-1
+			if used+cost<=count then
+				remain=math.floor(count-used-cost)
+				if not dry then
+					redis.call('hset',key,'w',idx,'c',cur+cost,'p',prev)
+					redis.call('pexpire',key,win*2)
+				end
+			end
+			return {remain,math.ceil(((idx+1)*win-now)/1000)}
+		end
+
+		local interval=win/count
+		local tat=tonumber(redis.call('get',key)) or now
+		if tat<now then
+			tat=now
+		end
+		local newTat=tat+interval*cost
+		if now<newTat-win then
+			return {-1,math.ceil((tat-now)/1000)}
 		end
+		if not dry and newTat>now then
+			redis.call('set',key,newTat,'PX',math.ceil(newTat-now))
+		end
+		return {math.floor((win-(newTat-now))/interval),math.ceil((newTat-now)/1000)}
+	end
+
+	local function run(dry)
+		local res={}
+		for i=1,%d do
+			local b=i*5-3
+			local r=limit(KEYS[i],tonumber(ARGV[b]),tonumber(ARGV[b+1]),tonumber(ARGV[b+2]),ARGV[b+3],tonumber(ARGV[b+4]),dry)
+			res[i*2-1]=r[1]
+			res[i*2]=r[2]
+		end
+		return res
 	end
-	return res
-	`)
 
-	redisSingleScript = stringx.CutSpace(`
-	local res={}
-	local ttl=redis.call('ttl',KEYS[1])
-	if ttl<0 then
-		redis.call('set',KEYS[1],ARGV[1]-1,'EX',ARGV[2])
-		res[1]=ARGV[1]-1
-		res[2]=tonumber(ARGV[2])
-	else
-		res[1]=redis.call('incrby',KEYS[1],-1)
-		res[2]=ttl
+	if ARGV[1]=='1' then
+		local res=run(true)
+		for i=1,#res,2 do
+			if res[i]<0 then
+				return res
+			end
+		end
 	end
-	return res
+	return run(false)
 	`)
+
+	redisSingleScript = fmt.Sprintf(redisScript, 1)
 )
 
 func (f *filter) limitCountErr(err error) api.ResultAction {
@@ -107,36 +201,33 @@ func (f *filter) limitCountErr(err error) api.ResultAction {
 	return api.Continue
 }
 
-func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	ctx := context.Background()
+func (f *filter) limitWithRedis(ctx context.Context, keys []string, args []interface{}) ([]interface{}, error) {
 	config := f.config
-	n := len(config.limiters)
-	keys := make([]string, n)
-	args := make([]interface{}, n*2)
-	for i, limiter := range config.limiters {
-		key := f.getKey(limiter.script, headers)
-		keys[i] = limiter.prefix + "|" + key
-
-		api.LogInfof("limitCountRedis filter, key: %s", key)
-
-		args[i*2] = limiter.count
-		args[i*2+1] = limiter.timeWindow
-	}
-
 	var ress []interface{}
 
 	if config.GetCluster() != nil {
+		if config.Hierarchical {
+			// All the keys share the same hash tag, so they can be checked in one script
+			cmd := config.clusterClient.Eval(ctx, fmt.Sprintf(redisScript, len(keys)), keys, args...)
+			res, err := cmd.Result()
+			if err != nil {
+				return nil, err
+			}
+			return res.([]interface{}), nil
+		}
+
 		// Redis cluster doesn't support operation across multiple slots, so we have to
 		// use pipeline to send the request one by one. We can't use hash tag because
 		// this will cause the key imbalence.
 		cmds, err := config.clusterClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
 			for i, k := range keys {
-				pipe.Eval(ctx, redisSingleScript, []string{k}, args[i*2], args[i*2+1])
+				ruleArgs := append([]interface{}{"0"}, args[1+i*5:1+i*5+5]...)
+				pipe.Eval(ctx, redisSingleScript, []string{k}, ruleArgs...)
 			}
 			return nil
 		})
 		if err != nil {
-			return f.limitCountErr(err)
+			return nil, err
 		}
 
 		ress = make([]interface{}, 2*len(cmds))
@@ -147,14 +238,81 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 		}
 
 	} else {
-		cmd := config.client.Eval(ctx, fmt.Sprintf(redisScript, n), keys, args...)
+		cmd := config.client.Eval(ctx, fmt.Sprintf(redisScript, len(keys)), keys, args...)
 		res, err := cmd.Result()
 		if err != nil {
-			return f.limitCountErr(err)
+			return nil, err
 		}
 
 		ress = res.([]interface{})
 	}
+	return ress, nil
+}
+
+func (f *filter) limitLocally(keys []string, costs []int64) []interface{} {
+	config := f.config
+	ress := make([]interface{}, 2*len(keys))
+	rejected := false
+	for i, limiter := range config.limiters {
+		remain, reset := limiter.local.limit(keys[i], costs[i])
+		ress[i*2] = remain
+		ress[i*2+1] = reset
+		if remain < 0 {
+			rejected = true
+		}
+	}
+	if rejected && config.Hierarchical {
+		for i, limiter := range config.limiters {
+			limiter.local.refund(keys[i], costs[i])
+		}
+	}
+	return ress
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	ctx := context.Background()
+	config := f.config
+	n := len(config.limiters)
+	keys := make([]string, n)
+	costs := make([]int64, n)
+	args := make([]interface{}, 1+n*5)
+	args[0] = "0"
+	if config.Hierarchical {
+		args[0] = "1"
+	}
+	for i, limiter := range config.limiters {
+		key := f.getKey(limiter.script, headers)
+		keys[i] = limiter.prefix + "|" + key
+		costs[i] = f.getCost(limiter.cost, headers)
+
+		api.LogInfof("limitCountRedis filter, key: %s, cost: %d", key, costs[i])
+
+		base := 1 + i*5
+		args[base] = limiter.count
+		args[base+1] = limiter.timeWindow
+		args[base+2] = int32(limiter.algorithm)
+		args[base+3] = ""
+		if limiter.algorithm == limitcountredis.Rule_SLIDING_WINDOW_LOG {
+			// the member of the sorted set should be unique
+			args[base+3] = strconv.FormatUint(rand.Uint64(), 36)
+		}
+		args[base+4] = costs[i]
+	}
+
+	var ress []interface{}
+	if config.health != nil && !config.health.available() {
+		ress = f.limitLocally(keys, costs)
+	} else {
+		var err error
+		ress, err = f.limitWithRedis(ctx, keys, args)
+		if err != nil {
+			if config.health == nil || !isUnreachable(err) {
+				return f.limitCountErr(err)
+			}
+			config.health.markUnavailable(err)
+			ress = f.limitLocally(keys, costs)
+		}
+	}
 	f.ress = ress
 
 	for i := range config.limiters {
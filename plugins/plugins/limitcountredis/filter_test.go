@@ -15,13 +15,19 @@
 package limitcountredis
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/google/cel-go/cel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 	"mosn.io/htnn/types/pkg/expr"
 )
@@ -65,3 +71,249 @@ func TestGetKey(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithms(t *testing.T) {
+	type step struct {
+		advance time.Duration
+		pass    bool
+		remain  string
+		reset   string
+	}
+
+	tests := []struct {
+		algorithm string
+		steps     []step
+	}{
+		{
+			algorithm: "FIXED_WINDOW",
+			steps: []step{
+				{pass: true, remain: "1", reset: "10"},
+				{advance: 4 * time.Second, pass: true, remain: "0", reset: "6"},
+				{pass: false},
+				{advance: 6 * time.Second, pass: true, remain: "1", reset: "10"},
+			},
+		},
+		{
+			algorithm: "SLIDING_WINDOW_LOG",
+			steps: []step{
+				{pass: true, remain: "1", reset: "10"},
+				{advance: 4 * time.Second, pass: true, remain: "0", reset: "6"},
+				{advance: 1 * time.Second, pass: false},
+				// the first request is out of the window
+				{advance: 5 * time.Second, pass: true, remain: "0", reset: "4"},
+				{pass: false},
+			},
+		},
+		{
+			algorithm: "SLIDING_WINDOW_COUNTER",
+			steps: []step{
+				{pass: true, remain: "1", reset: "10"},
+				{advance: 1 * time.Second, pass: true, remain: "0", reset: "9"},
+				{advance: 1 * time.Second, pass: false},
+				// the previous window is fully counted at the beginning of the next window
+				{advance: 8 * time.Second, pass: false},
+				// half of the previous window is counted
+				{advance: 5 * time.Second, pass: true, remain: "0", reset: "5"},
+				{advance: 1 * time.Second, pass: false},
+			},
+		},
+		{
+			algorithm: "GCRA",
+			steps: []step{
+				{pass: true, remain: "1", reset: "5"},
+				{pass: true, remain: "0", reset: "10"},
+				{pass: false},
+				// a token is emitted every 5 seconds
+				{advance: 4 * time.Second, pass: false},
+				{advance: 1 * time.Second, pass: true, remain: "0", reset: "10"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			s := miniredis.RunT(t)
+			// align to the window
+			now := time.Unix(1700000000, 0)
+			s.SetTime(now)
+
+			conf := &config{}
+			input := `{"address":"` + s.Addr() + `", "prefix":"test", "enableLimitQuotaHeaders": true,
+				"rules":[{"count":2,"timeWindow":"10s","algorithm":"` + tt.algorithm + `"}]}`
+			require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+			require.NoError(t, conf.Validate())
+			require.NoError(t, conf.Init(nil))
+
+			for i, st := range tt.steps {
+				if st.advance > 0 {
+					now = now.Add(st.advance)
+					s.SetTime(now)
+					s.FastForward(st.advance)
+				}
+
+				f := factory(conf, envoy.NewFilterCallbackHandler())
+				res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+				if !st.pass {
+					lr, ok := res.(*api.LocalResponse)
+					require.True(t, ok, "step %d", i)
+					assert.Equal(t, 429, lr.Code, "step %d", i)
+					continue
+				}
+
+				require.Equal(t, api.Continue, res, "step %d", i)
+				hdr := envoy.NewResponseHeaderMap(http.Header{})
+				f.EncodeHeaders(hdr, true)
+				remain, _ := hdr.Get("x-ratelimit-remaining")
+				assert.Equal(t, st.remain, remain, "step %d", i)
+				reset, _ := hdr.Get("x-ratelimit-reset")
+				assert.Equal(t, st.reset, reset, "step %d", i)
+				limit, _ := hdr.Get("x-ratelimit-limit")
+				assert.Equal(t, "2, 2;w=10", limit, "step %d", i)
+			}
+		})
+	}
+}
+
+func TestSingleScript(t *testing.T) {
+	s := miniredis.RunT(t)
+	conf := &config{}
+	input := `{"address":"` + s.Addr() + `", "prefix":"test", "rules":[{"count":1,"timeWindow":"10s","algorithm":"GCRA"}]}`
+	require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+	require.NoError(t, conf.Init(nil))
+
+	res, err := conf.client.Eval(context.Background(), redisSingleScript, []string{"key"}, "0", 1, 10, 3, "", 1).Slice()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(0), int64(10)}, res)
+	res, err = conf.client.Eval(context.Background(), redisSingleScript, []string{"key"}, "0", 1, 10, 3, "", 1).Slice()
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), res[0])
+}
+
+func TestGetCost(t *testing.T) {
+	cb := envoy.NewFilterCallbackHandler()
+	f := factory(&config{}, cb).(*filter)
+	h := http.Header{}
+	h.Set("x-cost", "5")
+	h.Set("x-negative-cost", "-5")
+	hdr := envoy.NewRequestHeaderMap(h)
+
+	tests := []struct {
+		name string
+		expr string
+		cost int64
+	}{
+		{
+			name: "default",
+			cost: 1,
+		},
+		{
+			name: "use expr",
+			expr: `int(request.header("x-cost"))`,
+			cost: 5,
+		},
+		{
+			name: "failed to evaluate",
+			expr: `int(request.header("x-missing-cost"))`,
+			cost: 1,
+		},
+		{
+			name: "negative",
+			expr: `int(request.header("x-negative-cost"))`,
+			cost: 1,
+		},
+		{
+			name: "free",
+			expr: `0`,
+			cost: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s expr.Script
+			if tt.expr != "" {
+				var err error
+				s, err = expr.CompileCel(tt.expr, cel.IntType)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.cost, f.getCost(s, hdr))
+		})
+	}
+}
+
+func TestCost(t *testing.T) {
+	for _, algorithm := range []string{"FIXED_WINDOW", "SLIDING_WINDOW_COUNTER", "GCRA"} {
+		t.Run(algorithm, func(t *testing.T) {
+			s := miniredis.RunT(t)
+			s.SetTime(time.Unix(1700000000, 0))
+
+			conf := &config{}
+			input := `{"address":"` + s.Addr() + `", "prefix":"test", "enableLimitQuotaHeaders": true,
+				"rules":[{"count":10,"timeWindow":"10s","algorithm":"` + algorithm + `",
+					"cost":"int(request.header(\"x-cost\"))"}]}`
+			require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+			require.NoError(t, conf.Validate())
+			require.NoError(t, conf.Init(nil))
+
+			for i, st := range []struct {
+				cost   string
+				pass   bool
+				remain string
+			}{
+				{cost: "4", pass: true, remain: "6"},
+				{cost: "5", pass: true, remain: "1"},
+				{cost: "2", pass: false},
+			} {
+				f := factory(conf, envoy.NewFilterCallbackHandler())
+				res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{"X-Cost": {st.cost}}), true)
+				if !st.pass {
+					_, ok := res.(*api.LocalResponse)
+					assert.True(t, ok, "step %d", i)
+					continue
+				}
+
+				require.Equal(t, api.Continue, res, "step %d", i)
+				hdr := envoy.NewResponseHeaderMap(http.Header{})
+				f.EncodeHeaders(hdr, true)
+				remain, _ := hdr.Get("x-ratelimit-remaining")
+				assert.Equal(t, st.remain, remain, "step %d", i)
+			}
+		})
+	}
+}
+
+func TestHierarchical(t *testing.T) {
+	for _, hierarchical := range []bool{true, false} {
+		t.Run(strconv.FormatBool(hierarchical), func(t *testing.T) {
+			s := miniredis.RunT(t)
+			s.SetTime(time.Unix(1700000000, 0))
+
+			conf := &config{}
+			// a tenant shares 3 requests between its consumers, and each consumer can send
+			// 2 requests
+			input := `{"address":"` + s.Addr() + `", "prefix":"test", "hierarchical":` + strconv.FormatBool(hierarchical) + `,
+				"rules":[
+					{"count":2,"timeWindow":"10s","key":"request.header(\"x-consumer\")"},
+					{"count":3,"timeWindow":"10s","key":"request.header(\"x-tenant\")","algorithm":"GCRA"}
+				]}`
+			require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+			require.NoError(t, conf.Validate())
+			require.NoError(t, conf.Init(nil))
+
+			send := func(consumer string) bool {
+				f := factory(conf, envoy.NewFilterCallbackHandler())
+				hdr := http.Header{"X-Consumer": {consumer}, "X-Tenant": {"t"}}
+				res := f.DecodeHeaders(envoy.NewRequestHeaderMap(hdr), true)
+				return res == api.Continue
+			}
+
+			assert.True(t, send("a"))
+			assert.True(t, send("a"))
+			// rejected by the consumer quota
+			assert.False(t, send("a"))
+			// the rejected request doesn't consume the tenant quota when it's hierarchical
+			assert.Equal(t, hierarchical, send("b"))
+			assert.False(t, send("b"))
+		})
+	}
+}
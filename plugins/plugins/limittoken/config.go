@@ -28,6 +28,7 @@ import (
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/plugins/plugins/limittoken/extractor"
 	"mosn.io/htnn/plugins/plugins/limittoken/limiter"
+	"mosn.io/htnn/plugins/plugins/limittoken/tokenizer"
 	"mosn.io/htnn/types/plugins/limittoken"
 )
 
@@ -61,11 +62,12 @@ func (p *plugin) Config() api.PluginConfig {
 // config holds the runtime configuration of the limittoken plugin
 type config struct {
 	limittoken.CustomConfig
-	rdb        *redis.Client
-	tokenStats *limiter.TokenStats
-	extractor  extractor.Extractor
-	regexps    []*regexp.Regexp
-	limiter    *limiter.Limiter
+	rdb          *redis.Client
+	tokenStats   *limiter.TokenStats
+	newExtractor func() extractor.Extractor
+	regexps      []*regexp.Regexp
+	tokenizer    tokenizer.Tokenizer
+	limiter      *limiter.Limiter
 }
 
 // Init initializes the plugin configuration
@@ -86,6 +88,9 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	if err := conf.initExtractor(); err != nil {
 		return err
 	}
+	if err := conf.initTokenizer(); err != nil {
+		return err
+	}
 	if err := conf.initLimiter(); err != nil {
 		return err
 	}
@@ -121,12 +126,17 @@ func (conf *config) initExtractor() error {
 	}
 
 	extractorTypeName := reflect.TypeOf(conf.ExtractorConfig).String()
-	newExtractor, err := extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
+	_, err := extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
 	if err != nil {
 		api.LogErrorf("failed to create newExtractor for provider type '%s': %v", extractorTypeName, err)
 		return err
 	}
-	conf.extractor = newExtractor
+	// The Extractor keeps the data of the request, so each request has its own Extractor
+	conf.newExtractor = func() extractor.Extractor {
+		// the config is already verified
+		e, _ := extractor.NewExtractor(extractorTypeName, conf.ExtractorConfig)
+		return e
+	}
 
 	return nil
 }
@@ -188,13 +198,33 @@ func (conf *config) initTokenStats() error {
 	return nil
 }
 
+// initTokenizer creates the tokenizers and selects them by the model of the request
+func (conf *config) initTokenizer() error {
+	tokenizers := conf.Tokenizers
+	if len(tokenizers) == 0 {
+		provider := conf.Tokenizer
+		if provider == "" {
+			provider = "openai"
+		}
+		tokenizers = []*limittoken.TokenizerConfig{{Provider: provider}}
+	}
+
+	router, err := tokenizer.NewRouter(tokenizers)
+	if err != nil {
+		api.LogErrorf("failed to create tokenizer: %v", err)
+		return err
+	}
+	conf.tokenizer = router
+	return nil
+}
+
 func (conf *config) initLimiter() error {
 	conf.limiter = limiter.NewLimiter(
 		limiter.WithRedisLimiter(conf.rdb),
 		limiter.WithRegexps(conf.regexps),
 		limiter.WithRejectedMsg(conf.RejectedMsg),
 		limiter.WithRejectedCode(int(conf.RejectedCode)),
-		limiter.WithTokenizer(conf.Tokenizer),
+		limiter.WithTokenizer(conf.tokenizer),
 		limiter.WithTokenStats(conf.tokenStats),
 	)
 	return nil
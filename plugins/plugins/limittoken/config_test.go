@@ -21,6 +21,7 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"mosn.io/htnn/api/pkg/plugins"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
@@ -191,7 +192,148 @@ func TestInitExtractor_OK(t *testing.T) {
 	}
 	err := conf.initExtractor()
 	assert.NoError(t, err)
-	assert.NotNil(t, conf.extractor)
+	// each request has its own extractor
+	e := conf.newExtractor()
+	assert.NotNil(t, e)
+	assert.NotSame(t, e, conf.newExtractor())
+}
+
+func TestInitTokenizer(t *testing.T) {
+	tests := []struct {
+		name       string
+		tokenizer  string
+		tokenizers []*limittoken.TokenizerConfig
+		err        string
+	}{
+		{
+			name: "default to openai",
+		},
+		{
+			name:      "openai",
+			tokenizer: "openai",
+		},
+		{
+			name:      "unknown provider",
+			tokenizer: "qwen",
+			err:       "unknown tokenizer provider: qwen",
+		},
+		{
+			name: "tokenizers",
+			tokenizers: []*limittoken.TokenizerConfig{
+				{Provider: "huggingface", Model: "^qwen", Path: "tokenizer/testdata/bpe_tokenizer.json"},
+				{Provider: "estimate"},
+			},
+		},
+		{
+			name: "bad tokenizer file",
+			tokenizers: []*limittoken.TokenizerConfig{
+				{Provider: "huggingface", Path: "tokenizer/testdata/nonexistent.json"},
+			},
+			err: "failed to read tokenizer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{
+				CustomConfig: limittoken.CustomConfig{
+					Config: limittoken.Config{
+						Tokenizer:  tt.tokenizer,
+						Tokenizers: tt.tokenizers,
+					},
+				},
+			}
+			err := conf.initTokenizer()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, conf.tokenizer)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "extractor is required",
+			input: `{}`,
+			err:   "invalid Config.ExtractorConfig",
+		},
+		{
+			name:  "preset",
+			input: `{"presetConfig":{"protocol":"ANTHROPIC_MESSAGES"}}`,
+		},
+		{
+			name:  "unknown protocol",
+			input: `{"presetConfig":{"protocol":"COHERE_CHAT"}}`,
+			err:   "invalid value for enum",
+		},
+		{
+			name:  "tokenizers",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"huggingface","model":"^qwen","path":"/etc/tokenizer.json"},{"provider":"estimate","bytesPerToken":3}]}`,
+		},
+		{
+			name:  "provider is required",
+			input: `{"presetConfig":{},"tokenizers":[{"model":"^qwen"}]}`,
+			err:   "invalid TokenizerConfig.Provider",
+		},
+		{
+			name:  "tokenizer conflicts with tokenizers",
+			input: `{"presetConfig":{},"tokenizer":"openai","tokenizers":[{"provider":"estimate"}]}`,
+			err:   "tokenizer and tokenizers can't be configured together",
+		},
+		{
+			name:  "bad model",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"estimate","model":"[qwen"}]}`,
+			err:   "bad tokenizer 0",
+		},
+		{
+			name:  "negative ratio",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"estimate","charsPerToken":-1}]}`,
+			err:   "can't be negative",
+		},
+		{
+			name:  "both ratios",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"estimate","charsPerToken":4,"bytesPerToken":3}]}`,
+			err:   "charsPerToken and bytesPerToken can't be configured together",
+		},
+		{
+			name:  "path is required",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"huggingface"}]}`,
+			err:   "path is required by the huggingface provider",
+		},
+		{
+			name:  "unknown tokenizer",
+			input: `{"presetConfig":{},"tokenizer":"qwen"}`,
+			err:   "unknown tokenizer provider: qwen",
+		},
+		{
+			name:  "unknown provider",
+			input: `{"presetConfig":{},"tokenizers":[{"provider":"estimate"},{"provider":"qwen"}]}`,
+			err:   "bad tokenizer 1: unknown tokenizer provider: qwen",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
 }
 
 func TestPlugin_Type(t *testing.T) {
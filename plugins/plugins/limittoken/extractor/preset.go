@@ -0,0 +1,85 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"mosn.io/htnn/plugins/pkg/llm"
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
+func init() {
+	var cfg *limittoken.Config_PresetConfig
+	typeName := reflect.TypeOf(cfg).String()
+	Register(typeName, NewPreset)
+}
+
+// PresetExtractor extracts the content, model and token usage with the built-in preset of the
+// LLM API.
+type PresetExtractor struct {
+	extractor *llm.Extractor
+}
+
+func NewPreset(config interface{}) (Extractor, error) {
+	wrapper, ok := config.(*limittoken.Config_PresetConfig)
+	if !ok {
+		return nil, errors.New("invalid config type for PresetExtractor")
+	}
+
+	configWrapper := wrapper.PresetConfig
+	if configWrapper == nil {
+		return nil, errors.New("PresetExtractor config is empty inside the wrapper")
+	}
+
+	extractor, err := llm.NewExtractor(configWrapper.Protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PresetExtractor{
+		extractor: extractor,
+	}, nil
+}
+
+func (p *PresetExtractor) SetData(data []byte) error {
+	return p.extractor.SetData(data)
+}
+
+// RequestContentAndModel returns the messages as a JSON array, so that the tokenizer can count
+// the tokens per message.
+func (p *PresetExtractor) RequestContentAndModel() (string, string) {
+	messages := p.extractor.RequestMessages()
+	if len(messages) == 0 {
+		return "", ""
+	}
+
+	content, err := json.Marshal(messages)
+	if err != nil {
+		return "", ""
+	}
+	return string(content), p.extractor.RequestModel()
+}
+
+func (p *PresetExtractor) ResponseContentAndModel() (string, string, int64, int64) {
+	completionTokens, promptTokens := p.extractor.ResponseUsage()
+	return p.extractor.ResponseContent(), p.extractor.ResponseModel(), completionTokens, promptTokens
+}
+
+func (p *PresetExtractor) StreamResponseContentAndModel() (string, string) {
+	return p.extractor.StreamResponseContent(), p.extractor.StreamResponseModel()
+}
@@ -0,0 +1,63 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
+func TestNewPreset(t *testing.T) {
+	_, err := NewPreset(buildGjsonConfig())
+	assert.ErrorContains(t, err, "invalid config type")
+	_, err = NewPreset(&limittoken.Config_PresetConfig{})
+	assert.ErrorContains(t, err, "config is empty")
+}
+
+func TestPresetExtractor(t *testing.T) {
+	ex, err := NewPreset(&limittoken.Config_PresetConfig{
+		PresetConfig: &limittoken.PresetConfig{Protocol: v1.LLMProtocol_OPENAI_RESPONSES},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ex.SetData([]byte(`{"model":"gpt-4.1","instructions":"Be brief.","input":"Hi"}`)))
+	content, model := ex.RequestContentAndModel()
+	assert.JSONEq(t, `[{"role":"system","content":"Be brief."},{"role":"user","content":"Hi"}]`, content)
+	assert.Equal(t, "gpt-4.1", model)
+
+	require.NoError(t, ex.SetData([]byte(`{"model":"gpt-4.1"}`)))
+	content, model = ex.RequestContentAndModel()
+	assert.Equal(t, "", content)
+	assert.Equal(t, "", model)
+
+	require.NoError(t, ex.SetData([]byte(`{"model":"gpt-4.1-2025-04-14",
+		"output":[{"type":"message","content":[{"type":"output_text","text":"Hello!"}]}],
+		"usage":{"input_tokens":10,"output_tokens":2}}`)))
+	content, model, completionTokens, promptTokens := ex.ResponseContentAndModel()
+	assert.Equal(t, "Hello!", content)
+	assert.Equal(t, "gpt-4.1-2025-04-14", model)
+	assert.Equal(t, int64(2), completionTokens)
+	assert.Equal(t, int64(10), promptTokens)
+
+	require.NoError(t, ex.SetData([]byte(`{"type":"response.output_text.delta","delta":"Hel"}`)))
+	content, model = ex.StreamResponseContentAndModel()
+	assert.Equal(t, "Hel", content)
+	assert.Equal(t, "", model)
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/plugins/limittoken/extractor"
 	"mosn.io/htnn/plugins/plugins/limittoken/sseparser"
 )
 
@@ -30,6 +31,7 @@ func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
 	return &filter{
 		callbacks:  callbacks,
 		config:     config,
+		extractor:  config.newExtractor(),
 		sseParser:  sseparser.NewStreamEventParser(),
 		bodyBuffer: []byte{},
 	}
@@ -46,6 +48,7 @@ type filter struct {
 
 	callbacks      api.FilterCallbackHandler
 	config         *config
+	extractor      extractor.Extractor
 	streamResponse bool // Whether response is streaming
 
 	sseParser      *sseparser.StreamEventParser // SSE event parser
@@ -111,7 +114,7 @@ func (f *filter) EncodeData(data api.BufferInstance, endStream bool) api.ResultA
 //   - Write to buffer and call moderation service
 //   - Block if violation, else pass original data
 func (f *filter) decodeDataHandler(headers api.RequestHeaderMap, data api.BufferInstance, endStream bool) api.ResultAction {
-	extractor := f.config.extractor
+	extractor := f.extractor
 	if len(f.bodyBuffer) == 0 && endStream {
 		// Single full body
 		err := extractor.SetData(data.Bytes())
@@ -148,7 +151,7 @@ func (f *filter) decodeDataHandler(headers api.RequestHeaderMap, data api.Buffer
 
 // encodeDataHandler processes non-streaming response data
 func (f *filter) encodeDataHandler(data api.BufferInstance, endStream bool) api.ResultAction {
-	extractor := f.config.extractor
+	extractor := f.extractor
 
 	if len(f.bodyBuffer) == 0 && endStream {
 		// Single full body
@@ -182,7 +185,7 @@ func (f *filter) encodeDataHandler(data api.BufferInstance, endStream bool) api.
 
 // streamDataHandler processes streaming response data (SSE)
 func (f *filter) streamDataHandler(data api.BufferInstance, endStream bool) api.ResultAction {
-	extractor := f.config.extractor
+	extractor := f.extractor
 
 	// 如果流已经关闭，则直接返回错误响应
 	if f.streamCloseFlag {
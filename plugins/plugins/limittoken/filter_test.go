@@ -21,6 +21,7 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
@@ -32,6 +33,8 @@ import (
 func TestFactory(t *testing.T) {
 	cb := envoy.NewFilterCallbackHandler()
 	conf := &config{}
+	conf.ExtractorConfig = &limittoken.Config_GjsonConfig{GjsonConfig: &limittoken.GjsonConfig{}}
+	require.NoError(t, conf.initExtractor())
 
 	// Create filter using factory
 	f := factory(conf, cb).(*filter)
@@ -41,6 +44,7 @@ func TestFactory(t *testing.T) {
 	assert.Equal(t, cb, f.callbacks)
 	assert.Equal(t, conf, f.config)
 	assert.NotNil(t, f.sseParser)
+	assert.NotNil(t, f.extractor)
 }
 
 // TestIsStream verifies the isStream helper for different content types
@@ -66,15 +66,10 @@ func WithRedisLimiter(rdb *redis.Client) Option {
 	}
 }
 
-// WithTokenizer sets the tokenizer provider
-func WithTokenizer(provider string) Option {
+// WithTokenizer sets the tokenizer to count the tokens
+func WithTokenizer(t tokenizer.Tokenizer) Option {
 	return func(l *Limiter) {
-		switch provider {
-		case "openai":
-			l.tokenizer = &tokenizer.OpenaiTokenizer{}
-		default:
-			l.tokenizer = &tokenizer.OpenaiTokenizer{}
-		}
+		l.tokenizer = t
 	}
 }
 
@@ -51,7 +51,7 @@ func TestOptions(t *testing.T) {
 	l := NewLimiter(
 		WithRegexps([]*regexp.Regexp{r}),
 		WithRedisLimiter(rdb),
-		WithTokenizer("openai"),
+		WithTokenizer(&tokenizer.OpenaiTokenizer{}),
 		WithTokenStats(ts),
 		WithRejectedMsg("reject"),
 		WithRejectedCode(499),
@@ -220,7 +220,7 @@ func TestDecodeData_ErrorBranches(t *testing.T) {
 
 	rule := &limittoken.Rule{LimitBy: &limittoken.Rule_LimitByConsumer{}}
 
-	l := NewLimiter(WithRedisLimiter(rdb), WithTokenizer("openai"))
+	l := NewLimiter(WithRedisLimiter(rdb), WithTokenizer(&tokenizer.OpenaiTokenizer{}))
 	hdr := envoy.NewRequestHeaderMap(http.Header{ConsumerHeader: []string{"user1"}})
 	res := l.DecodeData(hdr, rule, "some content", "gpt-3.5-turbo")
 
@@ -239,7 +239,7 @@ func TestDecodeData_ErrorBranches(t *testing.T) {
 
 	l = NewLimiter(
 		WithRedisLimiter(rdb),
-		WithTokenizer("openai"),
+		WithTokenizer(&tokenizer.OpenaiTokenizer{}),
 		WithTokenStats(&TokenStats{WindowSize: 3, MinSamples: 1, MaxRatio: 0.1, MaxTokensPerReq: 1}),
 	)
 	hdr = envoy.NewRequestHeaderMap(http.Header{ConsumerHeader: []string{"user1"}})
@@ -0,0 +1,57 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
+const (
+	DefaultCharsPerToken = 4
+)
+
+func init() {
+	Register("estimate", func(config *limittoken.TokenizerConfig) (Tokenizer, error) {
+		t := &EstimateTokenizer{
+			charsPerToken: float64(config.CharsPerToken),
+			bytesPerToken: float64(config.BytesPerToken),
+		}
+		if t.charsPerToken <= 0 && t.bytesPerToken <= 0 {
+			t.charsPerToken = DefaultCharsPerToken
+		}
+		return t, nil
+	})
+}
+
+// EstimateTokenizer estimates the tokens with the ratio of characters or bytes to tokens. It's
+// cheap and works for any model, but the result is approximate.
+type EstimateTokenizer struct {
+	charsPerToken float64
+	bytesPerToken float64
+}
+
+func (t *EstimateTokenizer) count(text string) int {
+	if t.bytesPerToken > 0 {
+		return int(math.Ceil(float64(len(text)) / t.bytesPerToken))
+	}
+	return int(math.Ceil(float64(utf8.RuneCountInString(text)) / t.charsPerToken))
+}
+
+func (t *EstimateTokenizer) GetToken(messagesStr, model string) (int, error) {
+	return countTokens(messagesStr, t.count), nil
+}
@@ -0,0 +1,581 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dlclark/regexp2"
+	"golang.org/x/text/unicode/norm"
+
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
+func init() {
+	Register("huggingface", func(config *limittoken.TokenizerConfig) (Tokenizer, error) {
+		return NewHuggingfaceTokenizer(config.Path)
+	})
+}
+
+// regexpMatchTimeout limits the time of a single match. The patterns come from the tokenizer.json
+// and regexp2 backtracks, so a bad pattern may take a long time on the crafted input.
+const regexpMatchTimeout = 100 * time.Millisecond
+
+func compileRegexp(pattern string) (*regexp2.Regexp, error) {
+	re, err := regexp2.Compile(pattern, regexp2.None)
+	if err != nil {
+		return nil, err
+	}
+	re.MatchTimeout = regexpMatchTimeout
+	return re, nil
+}
+
+func mustCompileRegexp(pattern string) *regexp2.Regexp {
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// byteLevelPattern is the pattern of GPT-2, used by the ByteLevel pre-tokenizer
+const byteLevelPattern = `'s|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+|\s+(?!\S)|\s+`
+
+// byteLevelAlphabet maps each byte to a printable character, like GPT-2 does
+var byteLevelAlphabet = func() [256]rune {
+	var table [256]rune
+	n := 0
+	for b := 0; b < 256; b++ {
+		if (b >= '!' && b <= '~') || (b >= 0xA1 && b <= 0xAC) || (b >= 0xAE && b <= 0xFF) {
+			table[b] = rune(b)
+		} else {
+			table[b] = rune(256 + n)
+			n++
+		}
+	}
+	return table
+}()
+
+type hfPattern struct {
+	String *string `json:"String"`
+	Regex  *string `json:"Regex"`
+}
+
+func (p *hfPattern) compile() (*regexp2.Regexp, error) {
+	if p.String != nil {
+		return compileRegexp(regexp2.Escape(*p.String))
+	}
+	if p.Regex != nil {
+		return compileRegexp(*p.Regex)
+	}
+	return nil, fmt.Errorf("pattern is required")
+}
+
+type hfNormalizer struct {
+	Type        string          `json:"type"`
+	Normalizers []*hfNormalizer `json:"normalizers"`
+	Prepend     string          `json:"prepend"`
+	Pattern     hfPattern       `json:"pattern"`
+	Content     string          `json:"content"`
+}
+
+type hfPreTokenizer struct {
+	Type             string            `json:"type"`
+	Pretokenizers    []*hfPreTokenizer `json:"pretokenizers"`
+	Pattern          hfPattern         `json:"pattern"`
+	Behavior         string            `json:"behavior"`
+	Invert           bool              `json:"invert"`
+	AddPrefixSpace   *bool             `json:"add_prefix_space"`
+	UseRegex         *bool             `json:"use_regex"`
+	Replacement      string            `json:"replacement"`
+	PrependScheme    string            `json:"prepend_scheme"`
+	Split            *bool             `json:"split"`
+	IndividualDigits bool              `json:"individual_digits"`
+}
+
+type hfModel struct {
+	Type         string            `json:"type"`
+	Vocab        map[string]int    `json:"vocab"`
+	Merges       []json.RawMessage `json:"merges"`
+	ByteFallback bool              `json:"byte_fallback"`
+	IgnoreMerges bool              `json:"ignore_merges"`
+}
+
+type hfAddedToken struct {
+	Content string `json:"content"`
+}
+
+type hfTokenizerFile struct {
+	AddedTokens  []hfAddedToken  `json:"added_tokens"`
+	Normalizer   *hfNormalizer   `json:"normalizer"`
+	PreTokenizer *hfPreTokenizer `json:"pre_tokenizer"`
+	Model        hfModel         `json:"model"`
+}
+
+type preTokenizer func(pieces []string) []string
+
+// HuggingfaceTokenizer counts the tokens with the BPE model in the HuggingFace `tokenizer.json`,
+// which is used by models like Qwen and Llama. Both the byte-level BPE and the SentencePiece
+// BPE with byte fallback are supported.
+type HuggingfaceTokenizer struct {
+	addedTokens   *regexp.Regexp
+	normalize     func(string) string
+	preTokenizers []preTokenizer
+
+	vocab        map[string]int
+	ranks        map[string]int
+	byteFallback bool
+	ignoreMerges bool
+}
+
+func NewHuggingfaceTokenizer(path string) (*HuggingfaceTokenizer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tokenizer: %w", err)
+	}
+
+	var file hfTokenizerFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse tokenizer %s: %w", path, err)
+	}
+
+	model := file.Model
+	if model.Type != "" && model.Type != "BPE" {
+		return nil, fmt.Errorf("unsupported model type %s in tokenizer %s", model.Type, path)
+	}
+
+	t := &HuggingfaceTokenizer{
+		vocab:        model.Vocab,
+		ranks:        make(map[string]int, len(model.Merges)),
+		byteFallback: model.ByteFallback,
+		ignoreMerges: model.IgnoreMerges,
+	}
+	for i, raw := range model.Merges {
+		var pair []string
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			pair = strings.SplitN(s, " ", 2)
+		} else if err := json.Unmarshal(raw, &pair); err != nil {
+			return nil, fmt.Errorf("invalid merge %s in tokenizer %s", raw, path)
+		}
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid merge %s in tokenizer %s", raw, path)
+		}
+		key := pair[0] + "\x00" + pair[1]
+		if _, ok := t.ranks[key]; !ok {
+			t.ranks[key] = i
+		}
+	}
+
+	if len(file.AddedTokens) > 0 {
+		contents := make([]string, 0, len(file.AddedTokens))
+		for _, tk := range file.AddedTokens {
+			if tk.Content != "" {
+				contents = append(contents, tk.Content)
+			}
+		}
+		// prefer the longest token
+		sort.Slice(contents, func(i, j int) bool {
+			return len(contents[i]) > len(contents[j])
+		})
+		for i, c := range contents {
+			contents[i] = regexp.QuoteMeta(c)
+		}
+		if len(contents) > 0 {
+			t.addedTokens = regexp.MustCompile(strings.Join(contents, "|"))
+		}
+	}
+
+	t.normalize, err = newNormalizer(file.Normalizer)
+	if err != nil {
+		return nil, fmt.Errorf("bad normalizer in tokenizer %s: %w", path, err)
+	}
+	if file.PreTokenizer != nil {
+		t.preTokenizers, err = newPreTokenizers(file.PreTokenizer)
+		if err != nil {
+			return nil, fmt.Errorf("bad pre_tokenizer in tokenizer %s: %w", path, err)
+		}
+	}
+
+	return t, nil
+}
+
+func newNormalizer(n *hfNormalizer) (func(string) string, error) {
+	if n == nil {
+		return func(s string) string { return s }, nil
+	}
+
+	switch n.Type {
+	case "Sequence":
+		fns := make([]func(string) string, 0, len(n.Normalizers))
+		for _, sub := range n.Normalizers {
+			fn, err := newNormalizer(sub)
+			if err != nil {
+				return nil, err
+			}
+			fns = append(fns, fn)
+		}
+		return func(s string) string {
+			for _, fn := range fns {
+				s = fn(s)
+			}
+			return s
+		}, nil
+	case "Prepend":
+		prepend := n.Prepend
+		return func(s string) string {
+			if s == "" {
+				return s
+			}
+			return prepend + s
+		}, nil
+	case "Replace":
+		content := n.Content
+		if n.Pattern.String != nil {
+			old := *n.Pattern.String
+			return func(s string) string {
+				return strings.ReplaceAll(s, old, content)
+			}, nil
+		}
+		re, err := n.Pattern.compile()
+		if err != nil {
+			return nil, err
+		}
+		return func(s string) string {
+			res, err := re.Replace(s, content, -1, -1)
+			if err != nil {
+				return s
+			}
+			return res
+		}, nil
+	case "NFC":
+		return norm.NFC.String, nil
+	case "NFD":
+		return norm.NFD.String, nil
+	case "NFKC":
+		return norm.NFKC.String, nil
+	case "NFKD":
+		return norm.NFKD.String, nil
+	case "Lowercase":
+		return strings.ToLower, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", n.Type)
+}
+
+func newPreTokenizers(p *hfPreTokenizer) ([]preTokenizer, error) {
+	switch p.Type {
+	case "Sequence":
+		var res []preTokenizer
+		for _, sub := range p.Pretokenizers {
+			fns, err := newPreTokenizers(sub)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, fns...)
+		}
+		return res, nil
+
+	case "Split":
+		re, err := p.Pattern.compile()
+		if err != nil {
+			return nil, err
+		}
+		behavior, invert := p.Behavior, p.Invert
+		return []preTokenizer{func(pieces []string) []string {
+			return splitPieces(pieces, re, behavior, invert)
+		}}, nil
+
+	case "ByteLevel":
+		addPrefixSpace := p.AddPrefixSpace == nil || *p.AddPrefixSpace
+		var re *regexp2.Regexp
+		if p.UseRegex == nil || *p.UseRegex {
+			re = mustCompileRegexp(byteLevelPattern)
+		}
+		return []preTokenizer{func(pieces []string) []string {
+			if addPrefixSpace {
+				for i, piece := range pieces {
+					if !strings.HasPrefix(piece, " ") {
+						pieces[i] = " " + piece
+					}
+				}
+			}
+			if re != nil {
+				pieces = splitPieces(pieces, re, "Isolated", false)
+			}
+			for i, piece := range pieces {
+				var sb strings.Builder
+				for j := 0; j < len(piece); j++ {
+					sb.WriteRune(byteLevelAlphabet[piece[j]])
+				}
+				pieces[i] = sb.String()
+			}
+			return pieces
+		}}, nil
+
+	case "Metaspace":
+		replacement := p.Replacement
+		if replacement == "" {
+			replacement = "▁"
+		}
+		scheme := p.PrependScheme
+		if scheme == "" {
+			scheme = "always"
+			if p.AddPrefixSpace != nil && !*p.AddPrefixSpace {
+				scheme = "never"
+			}
+		}
+		split := p.Split == nil || *p.Split
+		re := mustCompileRegexp(regexp2.Escape(replacement))
+		return []preTokenizer{func(pieces []string) []string {
+			for i, piece := range pieces {
+				piece = strings.ReplaceAll(piece, " ", replacement)
+				if (scheme == "always" || (scheme == "first" && i == 0)) && !strings.HasPrefix(piece, replacement) {
+					piece = replacement + piece
+				}
+				pieces[i] = piece
+			}
+			if split {
+				pieces = splitPieces(pieces, re, "MergedWithNext", false)
+			}
+			return pieces
+		}}, nil
+
+	case "Whitespace":
+		re := mustCompileRegexp(`\w+|[^\w\s]+`)
+		return []preTokenizer{func(pieces []string) []string {
+			return splitPieces(pieces, re, "Removed", true)
+		}}, nil
+
+	case "WhitespaceSplit":
+		return []preTokenizer{func(pieces []string) []string {
+			var res []string
+			for _, piece := range pieces {
+				res = append(res, strings.Fields(piece)...)
+			}
+			return res
+		}}, nil
+
+	case "Digits":
+		pattern := `\p{N}+`
+		if p.IndividualDigits {
+			pattern = `\p{N}`
+		}
+		re := mustCompileRegexp(pattern)
+		return []preTokenizer{func(pieces []string) []string {
+			return splitPieces(pieces, re, "Isolated", false)
+		}}, nil
+
+	case "Punctuation":
+		re := mustCompileRegexp(`\p{P}`)
+		return []preTokenizer{func(pieces []string) []string {
+			return splitPieces(pieces, re, "Isolated", false)
+		}}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", p.Type)
+}
+
+// splitPieces splits each piece by the delimiters matched by the regexp. When `invert` is true,
+// the non-matched parts are the delimiters. The "Contiguous" behavior is handled like "Isolated".
+func splitPieces(pieces []string, re *regexp2.Regexp, behavior string, invert bool) []string {
+	res := make([]string, 0, len(pieces))
+	for _, piece := range pieces {
+		type segment struct {
+			text  string
+			delim bool
+		}
+
+		// The index of regexp2 is in runes
+		runes := []rune(piece)
+		var segments []segment
+		last := 0
+		m, _ := re.FindStringMatch(piece)
+		for m != nil {
+			if m.Length > 0 {
+				if m.Index > last {
+					segments = append(segments, segment{text: string(runes[last:m.Index]), delim: invert})
+				}
+				segments = append(segments, segment{text: m.String(), delim: !invert})
+				last = m.Index + m.Length
+			}
+			m, _ = re.FindNextMatch(m)
+		}
+		if last < len(runes) {
+			segments = append(segments, segment{text: string(runes[last:]), delim: invert})
+		}
+
+		var pending string
+		for i, seg := range segments {
+			switch {
+			case !seg.delim || behavior == "Isolated" || behavior == "Contiguous" || behavior == "":
+				res = append(res, pending+seg.text)
+				pending = ""
+			case behavior == "MergedWithPrevious":
+				if i > 0 && len(res) > 0 && !segments[i-1].delim {
+					res[len(res)-1] += seg.text
+				} else {
+					res = append(res, seg.text)
+				}
+			case behavior == "MergedWithNext":
+				if pending != "" {
+					res = append(res, pending)
+				}
+				pending = seg.text
+			}
+		}
+		if pending != "" {
+			res = append(res, pending)
+		}
+	}
+	return res
+}
+
+type mergeCandidate struct {
+	rank  int
+	left  int
+	right int
+	size  int
+}
+
+type mergeQueue []mergeCandidate
+
+func (q mergeQueue) Len() int { return len(q) }
+func (q mergeQueue) Less(i, j int) bool {
+	if q[i].rank != q[j].rank {
+		return q[i].rank < q[j].rank
+	}
+	return q[i].left < q[j].left
+}
+func (q mergeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *mergeQueue) Push(x interface{}) { *q = append(*q, x.(mergeCandidate)) }
+func (q *mergeQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// countWord applies the BPE merges to the word and returns the number of the tokens.
+func (t *HuggingfaceTokenizer) countWord(word string) int {
+	if word == "" {
+		return 0
+	}
+	if t.ignoreMerges {
+		if _, ok := t.vocab[word]; ok {
+			return 1
+		}
+	}
+
+	type symbol struct {
+		text       string
+		prev, next int
+		removed    bool
+	}
+	symbols := make([]symbol, 0, len(word))
+	for _, r := range word {
+		n := len(symbols)
+		symbols = append(symbols, symbol{text: string(r), prev: n - 1, next: n + 1})
+	}
+	symbols[len(symbols)-1].next = -1
+
+	queue := &mergeQueue{}
+	push := func(left, right int) {
+		if left < 0 || right < 0 {
+			return
+		}
+		l, r := symbols[left].text, symbols[right].text
+		if rank, ok := t.ranks[l+"\x00"+r]; ok {
+			heap.Push(queue, mergeCandidate{rank: rank, left: left, right: right, size: len(l) + len(r)})
+		}
+	}
+	for i := 0; i < len(symbols)-1; i++ {
+		push(i, i+1)
+	}
+
+	for queue.Len() > 0 {
+		c := heap.Pop(queue).(mergeCandidate)
+		left, right := &symbols[c.left], &symbols[c.right]
+		// skip the stale candidate
+		if left.removed || right.removed || left.next != c.right || len(left.text)+len(right.text) != c.size {
+			continue
+		}
+
+		left.text += right.text
+		right.removed = true
+		left.next = right.next
+		if right.next >= 0 {
+			symbols[right.next].prev = c.left
+		}
+		push(left.prev, c.left)
+		push(c.left, left.next)
+	}
+
+	n := 0
+	for _, s := range symbols {
+		if s.removed {
+			continue
+		}
+		if _, ok := t.vocab[s.text]; ok || !t.byteFallback {
+			// the unknown symbol is counted as one token
+			n++
+		} else {
+			// each byte is a token like <0x41>
+			n += len(s.text)
+		}
+	}
+	return n
+}
+
+func (t *HuggingfaceTokenizer) countText(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	pieces := []string{t.normalize(text)}
+	for _, pre := range t.preTokenizers {
+		pieces = pre(pieces)
+	}
+
+	n := 0
+	for _, piece := range pieces {
+		n += t.countWord(piece)
+	}
+	return n
+}
+
+func (t *HuggingfaceTokenizer) count(text string) int {
+	if t.addedTokens == nil {
+		return t.countText(text)
+	}
+
+	n := 0
+	last := 0
+	for _, loc := range t.addedTokens.FindAllStringIndex(text, -1) {
+		n += t.countText(text[last:loc[0]]) + 1
+		last = loc[1]
+	}
+	return n + t.countText(text[last:])
+}
+
+func (t *HuggingfaceTokenizer) GetToken(messagesStr, model string) (int, error) {
+	return countTokens(messagesStr, t.count), nil
+}
@@ -0,0 +1,118 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHuggingfaceTokenizer(t *testing.T) {
+	writeTokenizer := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "tokenizer.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+	spModel := `"model": {"type": "BPE", "vocab": {"▁": 1, "h": 2, "i": 3, "▁h": 4, "▁hi": 5},
+		"merges": [["▁", "h"], ["▁h", "i"]], "byte_fallback": true}`
+	bpeModel := `"model": {"type": "BPE", "vocab": {"h": 0, "e": 1, "l": 2, "o": 3, "Ġ": 4, "w": 5, "r": 6, "d": 7,
+		"he": 8, "ll": 9, "hell": 10, "hello": 11, "Ġw": 12, "or": 13, "Ġwor": 14, "ld": 15, "Ġworld": 16},
+		"merges": ["h e", "l l", "he ll", "hell o", "Ġ w", "o r", "Ġw or", "l d", "Ġwor ld"]}`
+
+	tests := []struct {
+		name   string
+		path   string
+		counts map[string]int
+	}{
+		{
+			name: "byte-level BPE with split",
+			path: "testdata/bpe_tokenizer.json",
+			counts: map[string]int{
+				"":            0,
+				"hello world": 2,
+				// the first space is isolated
+				"hello  world":                3,
+				"<|im_start|>hello<|im_end|>": 3,
+				// the unknown character is counted as one token
+				"hi": 2,
+			},
+		},
+		{
+			name: "byte-level BPE",
+			path: writeTokenizer(t, `{"pre_tokenizer": {"type": "ByteLevel", "add_prefix_space": false, "use_regex": true}, `+bpeModel+`}`),
+			counts: map[string]int{
+				"hello world": 2,
+			},
+		},
+		{
+			name: "SentencePiece BPE",
+			path: "testdata/sp_tokenizer.json",
+			counts: map[string]int{
+				"hi hi": 2,
+				// the unknown character falls back to 3 bytes
+				"hi 你": 5,
+			},
+		},
+		{
+			name: "metaspace",
+			path: writeTokenizer(t, `{"pre_tokenizer": {"type": "Metaspace", "replacement": "▁", "prepend_scheme": "first", "split": true}, `+spModel+`}`),
+			counts: map[string]int{
+				"hi hi":  2,
+				"hi  hi": 3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk, err := NewHuggingfaceTokenizer(tt.path)
+			require.NoError(t, err)
+			for text, n := range tt.counts {
+				assert.Equal(t, n, tk.count(text), text)
+			}
+		})
+	}
+
+	tk, err := NewHuggingfaceTokenizer("testdata/bpe_tokenizer.json")
+	require.NoError(t, err)
+	n, err := tk.GetToken(`[{"role":"user","content":"hello world"}]`, "qwen")
+	require.NoError(t, err)
+	// 3 tokens per message, 2 tokens for the content, 4 tokens for the role and 3 tokens for the reply
+	assert.Equal(t, 12, n)
+
+	_, err = NewHuggingfaceTokenizer("testdata/nonexistent.json")
+	assert.ErrorContains(t, err, "failed to read tokenizer")
+	_, err = NewHuggingfaceTokenizer(writeTokenizer(t, `{"model": {"type": "Unigram"}}`))
+	assert.ErrorContains(t, err, "unsupported model type Unigram")
+	_, err = NewHuggingfaceTokenizer(writeTokenizer(t, `{"pre_tokenizer": {"type": "UnicodeScripts"}, `+bpeModel+`}`))
+	assert.ErrorContains(t, err, "unsupported type UnicodeScripts")
+}
+
+func TestHuggingfaceRegexpTimeout(t *testing.T) {
+	p := hfPattern{Regex: new(string)}
+	*p.Regex = `(a+)+$`
+	re, err := p.compile()
+	require.NoError(t, err)
+	assert.Equal(t, regexpMatchTimeout, re.MatchTimeout)
+
+	// catastrophic backtracking is stopped by the timeout, and the unmatched text is kept
+	piece := strings.Repeat("a", 64) + "!"
+	assert.Equal(t, []string{piece}, splitPieces([]string{piece}, re, "Isolated", false))
+}
@@ -22,8 +22,15 @@ import (
 	"github.com/pkoukk/tiktoken-go"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/limittoken"
 )
 
+func init() {
+	Register("openai", func(config *limittoken.TokenizerConfig) (Tokenizer, error) {
+		return &OpenaiTokenizer{}, nil
+	})
+}
+
 type OpenaiTokenizer struct{}
 
 type OpenaiPromptMessage struct {
@@ -14,6 +14,93 @@
 
 package tokenizer
 
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
 type Tokenizer interface {
 	GetToken(messagesStr, model string) (int, error)
 }
+
+type TokenizerFactory func(config *limittoken.TokenizerConfig) (Tokenizer, error)
+
+var registry = make(map[string]TokenizerFactory)
+
+func Register(provider string, factory TokenizerFactory) {
+	if _, ok := registry[provider]; ok {
+		panic(fmt.Sprintf("tokenizer factory named %s already registered", provider))
+	}
+	registry[provider] = factory
+}
+
+func NewTokenizer(config *limittoken.TokenizerConfig) (Tokenizer, error) {
+	factory, ok := registry[config.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown tokenizer provider: %s", config.Provider)
+	}
+	return factory(config)
+}
+
+type route struct {
+	model     *regexp.Regexp
+	tokenizer Tokenizer
+}
+
+// Router selects the tokenizer by the model of the request.
+type Router struct {
+	routes []route
+}
+
+func NewRouter(configs []*limittoken.TokenizerConfig) (*Router, error) {
+	r := &Router{
+		routes: make([]route, 0, len(configs)),
+	}
+	for _, config := range configs {
+		t, err := NewTokenizer(config)
+		if err != nil {
+			return nil, err
+		}
+
+		var re *regexp.Regexp
+		if config.Model != "" {
+			re, err = regexp.Compile(config.Model)
+			if err != nil {
+				return nil, fmt.Errorf("invalid model regexp %q: %w", config.Model, err)
+			}
+		}
+		r.routes = append(r.routes, route{model: re, tokenizer: t})
+	}
+	return r, nil
+}
+
+func (r *Router) GetToken(messagesStr, model string) (int, error) {
+	for _, rt := range r.routes {
+		if rt.model == nil || rt.model.MatchString(model) {
+			return rt.tokenizer.GetToken(messagesStr, model)
+		}
+	}
+	return 0, fmt.Errorf("no tokenizer matches model %s", model)
+}
+
+// countTokens counts the tokens of the messages with the given function. Like the OpenAI
+// tokenizer, each message takes 3 extra tokens for the chat template, and the reply is primed
+// with 3 tokens. The input which is not a JSON array of messages, like the content of the
+// response, is counted as a single text.
+func countTokens(messagesStr string, count func(text string) int) int {
+	var messages []OpenaiPromptMessage
+	if err := json.Unmarshal([]byte(messagesStr), &messages); err != nil {
+		return count(messagesStr)
+	}
+
+	numTokens := 0
+	for _, message := range messages {
+		numTokens += 3
+		numTokens += count(message.Content)
+		numTokens += count(message.Role)
+	}
+	return numTokens + 3
+}
@@ -0,0 +1,107 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/htnn/types/plugins/limittoken"
+)
+
+func TestNewTokenizer(t *testing.T) {
+	tk, err := NewTokenizer(&limittoken.TokenizerConfig{Provider: "openai"})
+	require.NoError(t, err)
+	assert.IsType(t, &OpenaiTokenizer{}, tk)
+
+	_, err = NewTokenizer(&limittoken.TokenizerConfig{Provider: "llama"})
+	assert.ErrorContains(t, err, "unknown tokenizer provider: llama")
+	_, err = NewRouter([]*limittoken.TokenizerConfig{{Provider: "estimate"}, {Provider: "llama"}})
+	assert.ErrorContains(t, err, "unknown tokenizer provider: llama")
+}
+
+func TestEstimateTokenizer(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *limittoken.TokenizerConfig
+		text   string
+		tokens int
+	}{
+		{
+			name:   "default",
+			config: &limittoken.TokenizerConfig{},
+			text:   "hello world",
+			tokens: 3,
+		},
+		{
+			name:   "characters",
+			config: &limittoken.TokenizerConfig{CharsPerToken: 1.5},
+			text:   "你好，世界",
+			tokens: 4,
+		},
+		{
+			name:   "bytes",
+			config: &limittoken.TokenizerConfig{BytesPerToken: 3},
+			text:   "你好，世界",
+			tokens: 5,
+		},
+		{
+			name:   "messages",
+			config: &limittoken.TokenizerConfig{},
+			text:   `[{"role":"user","content":"hello world"}]`,
+			tokens: 3 + 3 + 1 + 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Provider = "estimate"
+			tk, err := NewTokenizer(tt.config)
+			require.NoError(t, err)
+			n, err := tk.GetToken(tt.text, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.tokens, n)
+		})
+	}
+}
+
+func TestRouter(t *testing.T) {
+	r, err := NewRouter([]*limittoken.TokenizerConfig{
+		{Provider: "huggingface", Model: "^qwen", Path: "testdata/bpe_tokenizer.json"},
+		{Provider: "estimate", Model: "^llama", BytesPerToken: 1},
+	})
+	require.NoError(t, err)
+
+	n, err := r.GetToken("hello world", "qwen2.5-7b-instruct")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = r.GetToken("hello world", "llama-3-8b")
+	require.NoError(t, err)
+	assert.Equal(t, 11, n)
+	_, err = r.GetToken("hello world", "claude-3-5-sonnet")
+	assert.ErrorContains(t, err, "no tokenizer matches model claude-3-5-sonnet")
+
+	// the tokenizer without model matches all the models
+	r, err = NewRouter([]*limittoken.TokenizerConfig{
+		{Provider: "estimate", Model: "^qwen", BytesPerToken: 1},
+		{Provider: "estimate"},
+	})
+	require.NoError(t, err)
+	n, err = r.GetToken("hello world", "claude-3-5-sonnet")
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
@@ -0,0 +1,75 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtlsauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/types/plugins/mtlsauth"
+)
+
+func TestConsumerConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		index string
+		err   string
+	}{
+		{
+			name:  "fingerprint",
+			input: `{"fingerprint":"7C:2D:1B:B7:A2:B1:D7:E1:D8:B6:5C:5F:3E:E0:D0:C2:C1:B0:E4:F8:B7:A6:C5:D4:E3:F2:A1:B0:C9:D8:E7:F6"}`,
+			index: "fingerprint:7c2d1bb7a2b1d7e1d8b65c5f3ee0d0c2c1b0e4f8b7a6c5d4e3f2a1b0c9d8e7f6",
+		},
+		{
+			name:  "san",
+			input: `{"san":"spiffe://cluster.local/ns/default/sa/app"}`,
+			index: "san:spiffe://cluster.local/ns/default/sa/app",
+		},
+		{
+			name:  "invalid fingerprint",
+			input: `{"fingerprint":"7C:2D"}`,
+			err:   "invalid fingerprint",
+		},
+		{
+			name:  "empty san",
+			input: `{"san":""}`,
+			err:   "invalid san",
+		},
+		{
+			name:  "no identity",
+			input: `{}`,
+			err:   "value is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &mtlsauth.CustomConsumerConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.index, conf.Index())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtlsauth
+
+import (
+	"errors"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/plugins/mtlsauth"
+)
+
+// The attributes of the peer certificate, see
+// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes#connection-attributes
+var certificateProperties = map[mtlsauth.CertificateField]string{
+	mtlsauth.CertificateField_SUBJECT:     "connection.subject_peer_certificate",
+	mtlsauth.CertificateField_URI_SAN:     "connection.uri_san_peer_certificate",
+	mtlsauth.CertificateField_DNS_SAN:     "connection.dns_san_peer_certificate",
+	mtlsauth.CertificateField_FINGERPRINT: "connection.sha256_peer_certificate_digest",
+}
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	return &filter{
+		callbacks: callbacks,
+		config:    c.(*mtlsauth.Config),
+	}
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *mtlsauth.Config
+}
+
+func (f *filter) certificateField(field mtlsauth.CertificateField) string {
+	key := certificateProperties[field]
+	v, err := f.callbacks.GetProperty(key)
+	if err != nil {
+		if !errors.Is(err, api.ErrValueNotFound) {
+			api.LogErrorf("failed to get property %s: %v", key, err)
+		}
+		return ""
+	}
+	return v
+}
+
+func (f *filter) lookupConsumer() (api.Consumer, bool) {
+	name := mtlsauth.Name
+	if fp := f.certificateField(mtlsauth.CertificateField_FINGERPRINT); fp != "" {
+		if c, ok := f.callbacks.LookupConsumer(name, mtlsauth.FingerprintIndex(fp)); ok {
+			return c, true
+		}
+	}
+	for _, field := range []mtlsauth.CertificateField{
+		mtlsauth.CertificateField_URI_SAN,
+		mtlsauth.CertificateField_DNS_SAN,
+	} {
+		if san := f.certificateField(field); san != "" {
+			if c, ok := f.callbacks.LookupConsumer(name, mtlsauth.SANIndex(san)); ok {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	// Remove the forwarded headers sent by the client, so they can't be spoofed
+	for _, h := range f.config.ForwardHeaders {
+		headers.Del(h.Name)
+	}
+
+	// The digest is always present when the client provides a certificate
+	if f.certificateField(mtlsauth.CertificateField_FINGERPRINT) == "" {
+		return api.Continue
+	}
+
+	c, ok := f.lookupConsumer()
+	if !ok {
+		api.LogInfof("can not find consumer with the peer certificate, subject: %s",
+			f.certificateField(mtlsauth.CertificateField_SUBJECT))
+		return &api.LocalResponse{Code: 401, Msg: "unknown client certificate"}
+	}
+
+	for _, h := range f.config.ForwardHeaders {
+		if v := f.certificateField(h.Field); v != "" {
+			headers.Set(h.Name, v)
+		}
+	}
+	f.callbacks.SetConsumer(c)
+	return api.Continue
+}
@@ -0,0 +1,157 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtlsauth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/mtlsauth"
+)
+
+const (
+	fingerprint = "7c2d1bb7a2b1d7e1d8b65c5f3ee0d0c2c1b0e4f8b7a6c5d4e3f2a1b0c9d8e7f6"
+)
+
+func TestMtlsAuth(t *testing.T) {
+	name := mtlsauth.Name
+	consumers := map[string]api.Consumer{}
+	for _, conf := range []*mtlsauth.ConsumerConfig{
+		{Identity: &mtlsauth.ConsumerConfig_Fingerprint{Fingerprint: "7C:2D:1B:B7:A2:B1:D7:E1:D8:B6:5C:5F:3E:E0:D0:C2:C1:B0:E4:F8:B7:A6:C5:D4:E3:F2:A1:B0:C9:D8:E7:F6"}},
+		{Identity: &mtlsauth.ConsumerConfig_San{San: "spiffe://cluster.local/ns/default/sa/app"}},
+		{Identity: &mtlsauth.ConsumerConfig_San{San: "client.example.com"}},
+	} {
+		consumers[conf.Index()] = consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+			name: conf,
+		})
+	}
+
+	tests := []struct {
+		name     string
+		conf     string
+		props    map[string]string
+		hdr      http.Header
+		status   int
+		consumer string
+		expHdr   http.Header
+	}{
+		{
+			name: "no certificate",
+			conf: `{"forwardHeaders":[{"field":"SUBJECT","name":"x-client-subject"}]}`,
+			hdr:  http.Header{"X-Client-Subject": {"spoofed"}},
+			expHdr: http.Header{
+				"X-Client-Subject": nil,
+			},
+		},
+		{
+			name: "match fingerprint",
+			conf: `{"forwardHeaders":[{"field":"SUBJECT","name":"x-client-subject"},{"field":"DNS_SAN","name":"x-client-dns"}]}`,
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": fingerprint,
+				"connection.subject_peer_certificate":       "CN=client",
+			},
+			hdr:      http.Header{"X-Client-Subject": {"spoofed"}, "X-Client-Dns": {"spoofed"}},
+			consumer: mtlsauth.FingerprintIndex(fingerprint),
+			expHdr: http.Header{
+				"X-Client-Subject": {"CN=client"},
+				"X-Client-Dns":     nil,
+			},
+		},
+		{
+			name: "match uri san",
+			conf: `{"forwardHeaders":[{"field":"URI_SAN","name":"x-client-uri"}]}`,
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.uri_san_peer_certificate":       "spiffe://cluster.local/ns/default/sa/app",
+			},
+			consumer: mtlsauth.SANIndex("spiffe://cluster.local/ns/default/sa/app"),
+			expHdr: http.Header{
+				"X-Client-Uri": {"spiffe://cluster.local/ns/default/sa/app"},
+			},
+		},
+		{
+			name: "match dns san",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.uri_san_peer_certificate":       "spiffe://cluster.local/ns/default/sa/other",
+				"connection.dns_san_peer_certificate":       "client.example.com",
+			},
+			consumer: mtlsauth.SANIndex("client.example.com"),
+		},
+		{
+			name: "unknown certificate",
+			props: map[string]string{
+				"connection.sha256_peer_certificate_digest": "00",
+				"connection.dns_san_peer_certificate":       "unknown.example.com",
+			},
+			status: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			conf := &mtlsauth.Config{}
+			if tt.conf != "" {
+				require.NoError(t, protojson.Unmarshal([]byte(tt.conf), conf))
+			}
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(_, key string) (api.Consumer, bool) {
+				c, ok := consumers[key]
+				return c, ok
+			})
+			patches.ApplyMethodFunc(cb, "GetProperty", func(key string) (string, error) {
+				v, ok := tt.props[key]
+				if !ok {
+					return "", api.ErrValueNotFound
+				}
+				return v, nil
+			})
+			defer patches.Reset()
+
+			h := http.Header{":path": {"/"}}
+			for k, v := range tt.hdr {
+				h[k] = v
+			}
+			hdr := envoy.NewRequestHeaderMap(h)
+			res := f.DecodeHeaders(hdr, true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+			} else {
+				assert.Equal(t, api.Continue, res)
+			}
+
+			if tt.consumer != "" {
+				assert.Equal(t, consumers[tt.consumer], cb.GetConsumer())
+			} else {
+				assert.Nil(t, cb.GetConsumer())
+			}
+			for k, v := range tt.expHdr {
+				assert.Equal(t, v, hdr.Values(k), k)
+			}
+		})
+	}
+}
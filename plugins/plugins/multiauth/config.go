@@ -0,0 +1,99 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"fmt"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/plugins/multiauth"
+)
+
+func init() {
+	plugins.RegisterPlugin(multiauth.Name, &plugin{})
+}
+
+type plugin struct {
+	multiauth.Plugin
+}
+
+func (p *plugin) Factory() api.FilterFactory {
+	return factory
+}
+
+func (p *plugin) Config() api.PluginConfig {
+	return &config{}
+}
+
+type authPlugin struct {
+	name    string
+	factory api.FilterFactory
+	config  interface{}
+	// setConsumer is true if the plugin authenticates the client by setting the consumer
+	setConsumer bool
+}
+
+type config struct {
+	multiauth.CustomConfig
+
+	plugins []*authPlugin
+}
+
+// Parse parses the configs of the composed plugins, with the callbacks given to multiAuth
+func (conf *config) Parse(cb api.ConfigParsingCallbackHandler) error {
+	conf.plugins = make([]*authPlugin, 0, len(conf.Plugins))
+	for _, p := range conf.Plugins {
+		fp := plugins.LoadHTTPFilterFactoryAndParser(p.Name)
+		if fp == nil {
+			return fmt.Errorf("plugin %s not found", p.Name)
+		}
+
+		var raw interface{}
+		if p.Config != nil {
+			raw = p.Config.AsMap()
+		}
+		c, err := fp.ConfigParser.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse the config of plugin %s: %w", p.Name, err)
+		}
+		if parser, ok := c.(plugins.Parser); ok {
+			if err := parser.Parse(cb); err != nil {
+				return fmt.Errorf("failed to parse the config of plugin %s: %w", p.Name, err)
+			}
+		}
+
+		_, setConsumer := plugins.LoadPlugin(p.Name).(plugins.ConsumerPlugin)
+		conf.plugins = append(conf.plugins, &authPlugin{
+			name:        p.Name,
+			factory:     fp.Factory,
+			config:      c,
+			setConsumer: setConsumer,
+		})
+	}
+	return nil
+}
+
+// Init initializes the configs of the composed plugins, with the callbacks given to multiAuth
+func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	for _, p := range conf.plugins {
+		if initer, ok := p.config.(plugins.Initer); ok {
+			if err := initer.Init(cb); err != nil {
+				return fmt.Errorf("failed to init the config of plugin %s: %w", p.name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	_ "mosn.io/htnn/plugins/plugins/consumerrestriction"
+	_ "mosn.io/htnn/plugins/plugins/hmacauth"
+	_ "mosn.io/htnn/plugins/plugins/keyauth"
+	"mosn.io/htnn/types/plugins/multiauth"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "sanity",
+			input: `{"plugins":[{"name":"keyAuth","config":{"keys":[{"name":"x-api-key"}]}}]}`,
+		},
+		{
+			name:  "no plugin",
+			input: `{"plugins":[]}`,
+			err:   "invalid Config.Plugins",
+		},
+		{
+			name:  "unknown plugin",
+			input: `{"plugins":[{"name":"unknown"}]}`,
+			err:   "unknown plugin unknown",
+		},
+		{
+			name:  "not authn plugin",
+			input: `{"plugins":[{"name":"consumerRestriction"}]}`,
+			err:   "plugin consumerRestriction is not an authn plugin",
+		},
+		{
+			name:  "nested",
+			input: `{"plugins":[{"name":"multiAuth"}]}`,
+			err:   "multiAuth can't be nested",
+		},
+		{
+			name:  "body is not available",
+			input: `{"plugins":[{"name":"hmacAuth","config":{"bodyDigest":true}}]}`,
+			err:   "plugin hmacAuth with bodyDigest is not supported",
+		},
+		{
+			name:  "invalid plugin config",
+			input: `{"plugins":[{"name":"keyAuth","config":{"keys":[]}}]}`,
+			err:   "invalid config of plugin keyAuth",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &multiauth.CustomConfig{}
+			err := protojson.Unmarshal([]byte(tt.input), conf)
+			if err == nil {
+				err = conf.Validate()
+			}
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConsumerConfig(t *testing.T) {
+	conf := (&multiauth.Plugin{}).ConsumerConfig()
+	assert.ErrorContains(t, conf.Validate(), "multiAuth can't be configured in the consumer")
+}
+
+type callbacksPlugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *callbacksPlugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *callbacksPlugin) Factory() api.FilterFactory {
+	return func(interface{}, api.FilterCallbackHandler) api.Filter { return &api.PassThroughFilter{} }
+}
+
+func (p *callbacksPlugin) Config() api.PluginConfig {
+	return &callbacksConfig{}
+}
+
+type callbacksConfig struct {
+	emptypb.Empty
+
+	parsingCallbacks api.ConfigParsingCallbackHandler
+	callbacks        api.ConfigCallbackHandler
+}
+
+func (c *callbacksConfig) Validate() error {
+	return nil
+}
+
+func (c *callbacksConfig) Parse(cb api.ConfigParsingCallbackHandler) error {
+	c.parsingCallbacks = cb
+	return nil
+}
+
+func (c *callbacksConfig) Init(cb api.ConfigCallbackHandler) error {
+	c.callbacks = cb
+	return nil
+}
+
+func TestConfigPassCallbacks(t *testing.T) {
+	plugins.RegisterPlugin("multiAuthCallbacks", &callbacksPlugin{})
+
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"plugins":[{"name":"multiAuthCallbacks","config":{}}]}`), conf))
+	require.NoError(t, conf.Validate())
+
+	parsingCallbacks := &struct{ name string }{name: "parsing"}
+	callbacks := &struct{ name string }{name: "init"}
+	require.NoError(t, conf.Parse(parsingCallbacks))
+	require.NoError(t, conf.Init(callbacks))
+
+	c := conf.plugins[0].config.(*callbacksConfig)
+	assert.Same(t, parsingCallbacks, c.parsingCallbacks)
+	assert.Same(t, callbacks, c.callbacks)
+}
@@ -0,0 +1,106 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+func factory(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+	conf := c.(*config)
+	filters := make([]api.Filter, len(conf.plugins))
+	recorders := make([]*consumerRecorder, len(conf.plugins))
+	for i, p := range conf.plugins {
+		recorders[i] = &consumerRecorder{FilterCallbackHandler: callbacks}
+		filters[i] = p.factory(p.config, recorders[i])
+	}
+	return &filter{
+		callbacks: callbacks,
+		config:    conf,
+		filters:   filters,
+		recorders: recorders,
+	}
+}
+
+// consumerRecorder records the consumer set by the composed plugin, so that the consumer is only
+// set when the plugin succeeds.
+type consumerRecorder struct {
+	api.FilterCallbackHandler
+
+	consumer api.Consumer
+}
+
+func (r *consumerRecorder) SetConsumer(c api.Consumer) {
+	r.consumer = c
+}
+
+func (r *consumerRecorder) GetConsumer() api.Consumer {
+	if r.consumer != nil {
+		return r.consumer
+	}
+	return r.FilterCallbackHandler.GetConsumer()
+}
+
+type filter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	config    *config
+	filters   []api.Filter
+	recorders []*consumerRecorder
+}
+
+// DecodeHeaders runs the authn plugins in order and stops at the first one which authenticates
+// the client. A plugin which has consumers succeeds when it sets the consumer, and the other
+// plugins succeed when they don't reject the request. If all the plugins fail, the response of
+// the last rejection is returned. A result other than Continue or LocalResponse is a failure, as
+// the body is not available in the authn phase. The header mutations of a failed plugin are not
+// rolled back.
+func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	if f.callbacks.GetConsumer() != nil {
+		// already authenticated by other authn plugins
+		return api.Continue
+	}
+
+	var lastFailure api.ResultAction
+	for i, p := range f.config.plugins {
+		rec := f.recorders[i]
+		res := f.filters[i].DecodeHeaders(headers, endStream)
+		if res == nil || res == api.Continue {
+			if !p.setConsumer || rec.consumer != nil {
+				if rec.consumer != nil {
+					f.callbacks.SetConsumer(rec.consumer)
+				}
+				api.LogDebugf("authenticated by plugin %s", p.name)
+				return api.Continue
+			}
+			continue
+		}
+
+		// drop the consumer set by the failed plugin
+		rec.consumer = nil
+		if _, ok := res.(*api.LocalResponse); !ok {
+			api.LogErrorf("unsupported result %T from plugin %s", res, p.name)
+			res = &api.LocalResponse{Code: 401}
+		}
+		api.LogDebugf("failed to authenticate by plugin %s", p.name)
+		lastFailure = res
+	}
+
+	if lastFailure != nil {
+		return lastFailure
+	}
+	return api.Continue
+}
@@ -0,0 +1,236 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/consumer"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	_ "mosn.io/htnn/plugins/plugins/basicauth"
+	_ "mosn.io/htnn/plugins/plugins/keyauth"
+	"mosn.io/htnn/types/plugins/basicauth"
+	"mosn.io/htnn/types/plugins/keyauth"
+)
+
+func TestMultiAuth(t *testing.T) {
+	keyConsumer := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		keyauth.Name: &keyauth.ConsumerConfig{Key: "rick"},
+	})
+	basicConsumer := consumer.NewConsumer(map[string]api.PluginConsumerConfig{
+		// the hash of "password"
+		basicauth.Name: &basicauth.CustomConsumerConfig{
+			ConsumerConfig: basicauth.ConsumerConfig{Username: "morty", PasswordHash: "$2a$10$INPY9s5UXSsQkQ9lwO2mWekbofTIOzoD0Rm15swqn0AFktA6RqEUO"},
+		},
+	})
+	consumers := map[string]api.Consumer{
+		keyauth.Name + "/rick":    keyConsumer,
+		basicauth.Name + "/morty": basicConsumer,
+	}
+
+	conf := &config{}
+	require.NoError(t, protojson.Unmarshal([]byte(`{"plugins":[
+		{"name":"keyAuth","config":{"keys":[{"name":"x-api-key"}]}},
+		{"name":"basicAuth","config":{"realm":"internal"}}
+	]}`), conf))
+	require.NoError(t, conf.Validate())
+	require.NoError(t, conf.Parse(nil))
+	require.NoError(t, conf.Init(nil))
+
+	basic := func(username, password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+
+	tests := []struct {
+		name      string
+		hdr       http.Header
+		status    int
+		challenge string
+		consumer  api.Consumer
+	}{
+		{
+			name:     "key",
+			hdr:      http.Header{"X-Api-Key": {"rick"}},
+			consumer: keyConsumer,
+		},
+		{
+			name:     "basic",
+			hdr:      http.Header{"Authorization": {basic("morty", "password")}},
+			consumer: basicConsumer,
+		},
+		{
+			name:     "invalid key but valid basic",
+			hdr:      http.Header{"X-Api-Key": {"unknown"}, "Authorization": {basic("morty", "password")}},
+			consumer: basicConsumer,
+		},
+		{
+			name:      "all failed",
+			hdr:       http.Header{"X-Api-Key": {"unknown"}, "Authorization": {basic("morty", "wrong")}},
+			status:    401,
+			challenge: `Basic realm="internal"`,
+		},
+		{
+			name:      "return the last failure",
+			hdr:       http.Header{"X-Api-Key": {"unknown"}},
+			status:    401,
+			challenge: `Basic realm="internal"`,
+		},
+		{
+			name:      "no credential",
+			status:    401,
+			challenge: `Basic realm="internal"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			f := factory(conf, cb)
+
+			patches := gomonkey.ApplyMethodFunc(cb, "LookupConsumer", func(pluginName, key string) (api.Consumer, bool) {
+				c, ok := consumers[pluginName+"/"+key]
+				return c, ok
+			})
+			defer patches.Reset()
+
+			h := http.Header{":path": {"/"}}
+			for k, v := range tt.hdr {
+				h[k] = v
+			}
+			res := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true)
+			if tt.status != 0 {
+				r, ok := res.(*api.LocalResponse)
+				require.True(t, ok)
+				assert.Equal(t, tt.status, r.Code)
+				assert.Equal(t, tt.challenge, r.Header.Get("WWW-Authenticate"))
+			} else {
+				assert.Equal(t, api.Continue, res)
+			}
+			assert.Equal(t, tt.consumer, cb.GetConsumer())
+		})
+	}
+}
+
+type mockFilter struct {
+	api.PassThroughFilter
+
+	callbacks api.FilterCallbackHandler
+	consumer  api.Consumer
+	res       api.ResultAction
+}
+
+func (f *mockFilter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
+	if f.consumer != nil {
+		f.callbacks.SetConsumer(f.consumer)
+	}
+	return f.res
+}
+
+func mockPlugin(res api.ResultAction) *authPlugin {
+	return mockConsumerPlugin(res, nil)
+}
+
+func mockConsumerPlugin(res api.ResultAction, consumer api.Consumer) *authPlugin {
+	return &authPlugin{
+		factory: func(c interface{}, callbacks api.FilterCallbackHandler) api.Filter {
+			return &mockFilter{callbacks: callbacks, consumer: consumer, res: res}
+		},
+		setConsumer: consumer != nil,
+	}
+}
+
+func TestMultiAuthWithoutConsumer(t *testing.T) {
+	redirect := &api.LocalResponse{Code: 302}
+	tests := []struct {
+		name    string
+		plugins []*authPlugin
+		res     api.ResultAction
+	}{
+		{
+			name:    "passed",
+			plugins: []*authPlugin{mockPlugin(&api.LocalResponse{Code: 401}), mockPlugin(api.Continue)},
+			res:     api.Continue,
+		},
+		{
+			name:    "failed",
+			plugins: []*authPlugin{mockPlugin(&api.LocalResponse{Code: 401}), mockPlugin(redirect)},
+			res:     redirect,
+		},
+		{
+			name:    "unsupported result",
+			plugins: []*authPlugin{mockPlugin(api.WaitAllData), mockPlugin(redirect)},
+			res:     redirect,
+		},
+		{
+			name:    "unsupported result is a failure",
+			plugins: []*authPlugin{mockPlugin(&api.LocalResponse{Code: 403}), mockPlugin(api.WaitAllData)},
+			res:     &api.LocalResponse{Code: 401},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			f := factory(&config{plugins: tt.plugins}, cb)
+			res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+			assert.Equal(t, tt.res, res)
+		})
+	}
+}
+
+func TestMultiAuthDropConsumerOfFailedPlugin(t *testing.T) {
+	c := consumer.NewConsumer(nil)
+	tests := []struct {
+		name     string
+		plugins  []*authPlugin
+		res      api.ResultAction
+		consumer api.Consumer
+	}{
+		{
+			name:     "succeeded",
+			plugins:  []*authPlugin{mockConsumerPlugin(api.Continue, c)},
+			res:      api.Continue,
+			consumer: c,
+		},
+		{
+			name:    "rejected after setting the consumer",
+			plugins: []*authPlugin{mockConsumerPlugin(&api.LocalResponse{Code: 401}, c)},
+			res:     &api.LocalResponse{Code: 401},
+		},
+		{
+			name:    "unsupported result after setting the consumer",
+			plugins: []*authPlugin{mockConsumerPlugin(api.WaitAllData, c)},
+			res:     &api.LocalResponse{Code: 401},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := envoy.NewFilterCallbackHandler()
+			f := factory(&config{plugins: tt.plugins}, cb)
+			res := f.DecodeHeaders(envoy.NewRequestHeaderMap(http.Header{}), true)
+			assert.Equal(t, tt.res, res)
+			assert.Equal(t, tt.consumer, cb.GetConsumer())
+		})
+	}
+}
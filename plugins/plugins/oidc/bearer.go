@@ -0,0 +1,196 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/types/pkg/expr"
+)
+
+type claimMatcher struct {
+	name    string
+	path    []string
+	matcher expr.Matcher
+}
+
+func (conf *config) initBearerToken(provider *oidc.Provider) error {
+	bearer := conf.BearerToken
+	// The audience is checked by ourselves, as multiple audiences are allowed
+	conf.bearerVerifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
+	conf.bearerAudiences = bearer.Audiences
+	if len(conf.bearerAudiences) == 0 {
+		conf.bearerAudiences = []string{conf.ClientId}
+	}
+
+	conf.claimMatchers = make([]*claimMatcher, 0, len(bearer.RequiredClaims))
+	for _, c := range bearer.RequiredClaims {
+		matcher, err := expr.BuildStringMatcher(c.Matcher)
+		if err != nil {
+			return err
+		}
+		conf.claimMatchers = append(conf.claimMatchers, &claimMatcher{
+			name:    c.Name,
+			path:    strings.Split(c.Name, "."),
+			matcher: matcher,
+		})
+	}
+	return nil
+}
+
+func getBearerToken(headers api.RequestHeaderMap) (string, bool) {
+	authz, ok := headers.Get("authorization")
+	if !ok {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(authz, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func lookupClaim(claims map[string]any, path []string) (any, bool) {
+	var v any = claims
+	for _, key := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		v, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func claimToString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// claimValues returns the values used to match the claim. Each item is returned if the claim is an array.
+func claimValues(v any) []string {
+	if items, ok := v.([]any); ok {
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, claimToString(item))
+		}
+		return values
+	}
+	return []string{claimToString(v)}
+}
+
+// grantedScopes returns the scopes in the `scope` claim (RFC 9068) or the `scp` claim
+func grantedScopes(claims map[string]any) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	scp, ok := claims["scp"]
+	if !ok {
+		return nil
+	}
+	if s, ok := scp.(string); ok {
+		return strings.Fields(s)
+	}
+	return claimValues(scp)
+}
+
+func bearerChallenge(code int, errorCode string, desc string) *api.LocalResponse {
+	challenge := "Bearer"
+	if errorCode != "" {
+		challenge = fmt.Sprintf(`Bearer error="%s", error_description="%s"`, errorCode, desc)
+	}
+	return &api.LocalResponse{
+		Code:   code,
+		Msg:    desc,
+		Header: http.Header{"Www-Authenticate": []string{challenge}},
+	}
+}
+
+// handleBearerToken validates the bearer token like a resource server.
+// See https://www.rfc-editor.org/rfc/rfc6750
+func (f *filter) handleBearerToken(headers api.RequestHeaderMap, rawToken string) api.ResultAction {
+	config := f.config
+	ctx := config.ctxWithClient(f.callbacks.Context())
+	// The verifier checks the signature, issuer and expiry with the keys from the discovery
+	token, err := config.bearerVerifier.Verify(ctx, rawToken)
+	if err != nil {
+		api.LogInfof("bad bearer token: %v", err)
+		return bearerChallenge(401, "invalid_token", "bad token")
+	}
+	if !slices.ContainsFunc(token.Audience, func(aud string) bool {
+		return slices.Contains(config.bearerAudiences, aud)
+	}) {
+		api.LogInfof("bad bearer token, unexpected audience: %v", token.Audience)
+		return bearerChallenge(401, "invalid_token", "bad audience")
+	}
+
+	var raw json.RawMessage
+	err = token.Claims(&raw)
+	if err != nil {
+		api.LogInfof("bad bearer token: %v", err)
+		return bearerChallenge(401, "invalid_token", "bad token")
+	}
+	var claims map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	// keep the big integer like `iat` as it is
+	dec.UseNumber()
+	err = dec.Decode(&claims)
+	if err != nil {
+		api.LogInfof("bad bearer token: %v", err)
+		return bearerChallenge(401, "invalid_token", "bad token")
+	}
+
+	if required := config.BearerToken.RequiredScopes; len(required) > 0 {
+		scopes := grantedScopes(claims)
+		for _, scope := range required {
+			if !slices.Contains(scopes, scope) {
+				api.LogInfof("insufficient scope, required: %v, granted: %v", required, scopes)
+				return bearerChallenge(403, "insufficient_scope", "insufficient scope")
+			}
+		}
+	}
+
+	for _, m := range config.claimMatchers {
+		v, ok := lookupClaim(claims, m.path)
+		if !ok || !slices.ContainsFunc(claimValues(v), m.matcher.Match) {
+			api.LogInfof("claim %s mismatched, value: %v", m.name, v)
+			return &api.LocalResponse{Code: 403, Msg: "claim mismatched"}
+		}
+	}
+
+	// the headers from the client are already removed
+	for _, c := range config.BearerToken.ClaimsToHeaders {
+		v, ok := lookupClaim(claims, strings.Split(c.Claim, "."))
+		if ok {
+			headers.Set(c.Header, claimToString(v))
+		}
+	}
+	return api.Continue
+}
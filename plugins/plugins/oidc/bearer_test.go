@@ -0,0 +1,178 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/api/v1"
+	oidctype "mosn.io/htnn/types/plugins/oidc"
+)
+
+func TestBearerToken(t *testing.T) {
+	defaultBearer := &oidctype.BearerToken{
+		RequiredScopes: []string{"read"},
+		RequiredClaims: []*oidctype.ClaimMatcher{
+			{
+				Name:    "realm_access.roles",
+				Matcher: &v1.StringMatcher{MatchPattern: &v1.StringMatcher_Exact{Exact: "admin"}},
+			},
+		},
+		ClaimsToHeaders: []*oidctype.ClaimToHeader{
+			{Claim: "sub", Header: "x-user"},
+			{Claim: "realm_access.roles", Header: "x-roles"},
+			{Claim: "iat", Header: "x-iat"},
+			{Claim: "tenant", Header: "x-tenant"},
+		},
+	}
+	claims := `{"sub":"alice","iat":1718000000123,"scope":"read write","realm_access":{"roles":["user","admin"]}}`
+
+	tests := []struct {
+		name    string
+		bearer  *oidctype.BearerToken
+		authz   string
+		token   *oidc.IDToken
+		claims  string
+		err     error
+		res     api.ResultAction
+		code    int
+		wwwAuth string
+		upHdr   map[string]string
+	}{
+		{
+			name:   "sanity",
+			authz:  "Bearer xxx",
+			token:  &oidc.IDToken{Audience: []string{"9119df09-b20b-4c08-ba08-72472dda2cd2"}},
+			claims: claims,
+			res:    api.Continue,
+			upHdr: map[string]string{
+				"authorization": "Bearer xxx",
+				"x-user":        "alice",
+				"x-roles":       `["user","admin"]`,
+				"x-iat":         "1718000000123",
+				"x-tenant":      "",
+			},
+		},
+		{
+			name: "scp claim and custom audience",
+			bearer: &oidctype.BearerToken{
+				Audiences:      []string{"api", "api2"},
+				RequiredScopes: []string{"read", "write"},
+			},
+			authz:  "bearer xxx",
+			token:  &oidc.IDToken{Audience: []string{"api2"}},
+			claims: `{"scp":["read","write"]}`,
+			res:    api.Continue,
+		},
+		{
+			name:    "bad token",
+			authz:   "Bearer xxx",
+			err:     assert.AnError,
+			code:    401,
+			wwwAuth: `Bearer error="invalid_token", error_description="bad token"`,
+		},
+		{
+			name:    "bad audience",
+			authz:   "Bearer xxx",
+			token:   &oidc.IDToken{Audience: []string{"other"}},
+			claims:  claims,
+			code:    401,
+			wwwAuth: `Bearer error="invalid_token", error_description="bad audience"`,
+		},
+		{
+			name:    "insufficient scope",
+			authz:   "Bearer xxx",
+			token:   &oidc.IDToken{Audience: []string{"9119df09-b20b-4c08-ba08-72472dda2cd2"}},
+			claims:  `{"scope":"write","realm_access":{"roles":["admin"]}}`,
+			code:    403,
+			wwwAuth: `Bearer error="insufficient_scope", error_description="insufficient scope"`,
+		},
+		{
+			name:   "claim mismatched",
+			authz:  "Bearer xxx",
+			token:  &oidc.IDToken{Audience: []string{"9119df09-b20b-4c08-ba08-72472dda2cd2"}},
+			claims: `{"scope":"read","realm_access":{"roles":["user"]}}`,
+			code:   403,
+		},
+		{
+			name:   "claim missing",
+			authz:  "Bearer xxx",
+			token:  &oidc.IDToken{Audience: []string{"9119df09-b20b-4c08-ba08-72472dda2cd2"}},
+			claims: `{"scope":"read","realm_access":"admin"}`,
+			code:   403,
+		},
+		{
+			name:    "bearer only",
+			bearer:  &oidctype.BearerToken{BearerOnly: true},
+			authz:   "Basic xxx",
+			code:    401,
+			wwwAuth: "Bearer",
+		},
+		{
+			name:  "fallback to the login",
+			authz: "Basic xxx",
+			code:  http.StatusFound,
+			// the forged header is removed even if the bearer token is not used
+			upHdr: map[string]string{
+				"x-tenant": "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := getCfg()
+			conf.BearerToken = defaultBearer
+			if tt.bearer != nil {
+				conf.BearerToken = tt.bearer
+			}
+			assert.NoError(t, conf.initBearerToken(&oidc.Provider{}))
+
+			var token *oidc.IDToken
+			if tt.token != nil {
+				token = withClaims(tt.token, tt.claims)
+			}
+			patches := gomonkey.ApplyMethodReturn(conf.bearerVerifier, "Verify", token, tt.err)
+			defer patches.Reset()
+
+			f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+			h := http.Header{}
+			h.Set(":path", "/echo")
+			h.Set("authorization", tt.authz)
+			h.Set("x-tenant", "forged")
+			hdr := envoy.NewRequestHeaderMap(h)
+			res := f.DecodeHeaders(hdr, true)
+			if tt.res != nil {
+				assert.Equal(t, tt.res, res)
+			} else {
+				resp := res.(*api.LocalResponse)
+				assert.Equal(t, tt.code, resp.Code)
+				assert.Equal(t, tt.wwwAuth, resp.Header.Get("WWW-Authenticate"))
+			}
+
+			for k, v := range tt.upHdr {
+				actual, _ := hdr.Get(k)
+				assert.Equal(t, v, actual, k)
+			}
+		})
+	}
+}
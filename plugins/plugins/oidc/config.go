@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"net/url"
+	"runtime"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -27,6 +29,7 @@ import (
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/pkg/request"
 	oidctype "mosn.io/htnn/types/plugins/oidc"
 )
 
@@ -50,20 +53,27 @@ type config struct {
 	oidctype.CustomConfig
 
 	opTimeout      time.Duration
+	httpClient     *http.Client
 	oauth2Config   *oauth2.Config
 	verifier       *oidc.IDTokenVerifier
 	cookieEncoding *securecookie.SecureCookie
 	refreshLeeway  time.Duration
 	cookieEntryID  string
 	oidcProvider   *oidc.Provider
+	sessionStore   sessionStore
+
+	endSessionEndpoint *url.URL
+
+	bearerVerifier  *oidc.IDTokenVerifier
+	bearerAudiences []string
+	claimMatchers   []*claimMatcher
 }
 
 func (conf *config) ctxWithClient(ctx context.Context) context.Context {
 	if existing := ctx.Value(oauth2.HTTPClient); existing != nil {
 		return ctx
 	}
-	httpClient := &http.Client{Timeout: conf.opTimeout}
-	return context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	return context.WithValue(ctx, oauth2.HTTPClient, conf.httpClient)
 }
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
@@ -81,6 +91,18 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	}
 	conf.opTimeout = du
 
+	client, err := request.NewClient(request.ClientOptions{
+		Name:           oidctype.Name,
+		Timeout:        conf.opTimeout,
+		TLS:            request.NewTLSOptions(conf.Tls),
+		Retry:          request.NewRetryPolicy(conf.Retry),
+		CircuitBreaker: request.NewCircuitBreakerPolicy(conf.CircuitBreaker),
+	})
+	if err != nil {
+		return err
+	}
+	conf.httpClient = client
+
 	du = 10 * time.Second
 	leeway := conf.GetAccessTokenRefreshLeeway()
 	if leeway != nil {
@@ -90,7 +112,6 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 
 	ctx := conf.ctxWithClient(context.Background())
 	var provider *oidc.Provider
-	var err error
 	err = retry.Do(
 		func() error {
 			provider, err = oidc.NewProvider(ctx, conf.Issuer)
@@ -129,7 +150,41 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 	}
 	conf.cookieEncoding = securecookie.New([]byte(conf.ClientSecret), blockKey)
 
+	var claims struct {
+		EndSessionEndpoint string `json:"end_session_endpoint"`
+	}
+	err = provider.Claims(&claims)
+	if err != nil {
+		return err
+	}
+	if claims.EndSessionEndpoint != "" {
+		conf.endSessionEndpoint, err = url.Parse(claims.EndSessionEndpoint)
+		if err != nil {
+			return err
+		}
+	}
+
 	conf.verifier = provider.Verifier(&oidc.Config{ClientID: conf.ClientId})
 	conf.cookieEntryID = base64.RawURLEncoding.EncodeToString([]byte(conf.ClientId))
+
+	if conf.BearerToken != nil {
+		err = conf.initBearerToken(provider)
+		if err != nil {
+			return err
+		}
+	}
+
+	if redisConf := conf.GetRedisSessionStore(); redisConf != nil {
+		store := newRedisSessionStore(redisConf)
+		conf.sessionStore = store
+		runtime.SetFinalizer(conf, func(conf *config) {
+			err := store.Close()
+			if err != nil {
+				api.LogErrorf("failed to close redis client, err: %v", err)
+			}
+		})
+	} else if memConf := conf.GetMemorySessionStore(); memConf != nil {
+		conf.sessionStore = getMemorySessionStore(conf.Issuer, conf.cookieEntryID, memConf)
+	}
 	return nil
 }
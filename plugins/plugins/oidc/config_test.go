@@ -73,6 +73,48 @@ func TestConfig(t *testing.T) {
 			name:  "leeway can be 0s",
 			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "accessTokenRefreshLeeway":"0s"}`,
 		},
+		{
+			name:  "bad redis session store",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "redisSessionStore":{}}`,
+			err:   "invalid RedisSessionStore.Address",
+		},
+		{
+			name:  "SameSite=None requires secure",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookie":{"sameSite":"NONE"}}`,
+			err:   "secure is required when same_site is NONE",
+		},
+		{
+			name:  "cookie",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "cookie":{"sameSite":"NONE", "secure":true, "domain":"example.com", "path":"/"}}`,
+		},
+		{
+			name:  "bad logout",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "logout":{"postLogoutRedirectUrl":"/bye"}}`,
+			err:   "invalid Logout.Path",
+		},
+		{
+			name:  "back-channel logout requires a session store",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "backchannelLogout":{"path":"/backchannel_logout"}}`,
+			err:   "a session store is required",
+		},
+		{
+			name:  "claim matcher is required",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "bearerToken":{"requiredClaims":[{"name":"sub"}]}}`,
+			err:   "invalid ClaimMatcher.Matcher",
+		},
+		{
+			name:  "bearer token",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "bearerToken":{"audiences":["api"], "requiredScopes":["read"], "requiredClaims":[{"name":"sub", "matcher":{"prefix":"a"}}], "claimsToHeaders":[{"claim":"sub", "header":"x-user"}], "bearerOnly":true}}`,
+		},
+		{
+			name:  "invalid header name",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "bearerToken":{"claimsToHeaders":[{"claim":"sub", "header":"x user"}]}}`,
+			err:   "invalid ClaimToHeader.Header",
+		},
+		{
+			name:  "back-channel logout",
+			input: `{"clientId":"a", "clientSecret":"b", "issuer":"https://google.com", "redirectUrl":"http://127.0.0.1:10000/echo", "backchannelLogout":{"path":"/backchannel_logout"}, "memorySessionStore":{}}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,7 +136,7 @@ func TestConfig(t *testing.T) {
 func TestCtxWithClient(t *testing.T) {
 	// Test configuration
 	conf := &config{
-		opTimeout: 30 * time.Second,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
 	}
 
 	t.Run("should inject new client when no HTTPClient exists", func(t *testing.T) {
@@ -107,8 +149,8 @@ func TestCtxWithClient(t *testing.T) {
 			t.Fatal("Expected HTTPClient in context")
 		}
 
-		if client.Timeout != conf.opTimeout {
-			t.Errorf("Expected timeout %v, got %v", conf.opTimeout, client.Timeout)
+		if client != conf.httpClient {
+			t.Error("Expected the client of the config")
 		}
 	})
 
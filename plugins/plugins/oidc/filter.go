@@ -46,8 +46,13 @@ type filter struct {
 	callbacks      api.FilterCallbackHandler
 	config         *config
 	authDataCookie *http.Cookie
+	sessionID      string
 }
 
+const (
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
 type AuthData struct {
 	IDToken      string        `json:"id_token"`
 	Oauth2Token  *oauth2.Token `json:"oauth_token"`
@@ -109,6 +114,32 @@ func (f *filter) CookieName(key string) string {
 	return fmt.Sprintf("htnn_oidc_%s_%s", key, f.config.cookieEntryID)
 }
 
+// newCookie creates a cookie with the configured attributes
+func (f *filter) newCookie(name string, value string, maxAge int) *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+	}
+
+	attrs := f.config.Cookie
+	if attrs != nil {
+		cookie.Domain = attrs.Domain
+		cookie.Path = attrs.Path
+		cookie.Secure = attrs.Secure
+		switch attrs.SameSite {
+		case oidc.Cookie_LAX:
+			cookie.SameSite = http.SameSiteLaxMode
+		case oidc.Cookie_STRICT:
+			cookie.SameSite = http.SameSiteStrictMode
+		case oidc.Cookie_NONE:
+			cookie.SameSite = http.SameSiteNoneMode
+		}
+	}
+	return cookie
+}
+
 func (f *filter) handleInitRequest(headers api.RequestHeaderMap) api.ResultAction {
 	config := f.config
 	o2conf := config.oauth2Config
@@ -131,13 +162,7 @@ func (f *filter) handleInitRequest(headers api.RequestHeaderMap) api.ResultActio
 		api.LogErrorf("failed to encode cookie: %v", err)
 		return &api.LocalResponse{Code: 503, Msg: "failed to encode cookie"}
 	}
-	cookieNonce := &http.Cookie{
-		Name:     cookieName,
-		Value:    n,
-		MaxAge:   int(time.Hour.Seconds()),
-		HttpOnly: true,
-		// TODO: allow configuring the cookie attributes
-	}
+	cookieNonce := f.newCookie(cookieName, n, int(time.Hour.Seconds()))
 
 	return &api.LocalResponse{
 		Code: http.StatusFound,
@@ -178,7 +203,7 @@ func (f *filter) refreshEnabled(token *oauth2.Token) bool {
 func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values) api.ResultAction {
 	config := f.config
 	o2conf := config.oauth2Config
-	ctx := context.Background()
+	ctx := f.callbacks.Context()
 	code := query.Get("code")
 	state := query.Get("state")
 
@@ -241,7 +266,7 @@ func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values)
 		return &api.LocalResponse{Code: 403, Msg: "failed to fetch userinfo"}
 	}
 
-	cookie, err := f.saveAuthDataAsCookie(ctx, &AuthData{
+	cookie, err := f.saveAuthData(ctx, &AuthData{
 		Oauth2Token:  oauth2Token,
 		IDToken:      rawIDToken,
 		UserInfoJSON: rawUserInfoJSON,
@@ -261,16 +286,41 @@ func (f *filter) handleCallback(headers api.RequestHeaderMap, query url.Values)
 }
 
 func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedAuthData string) api.ResultAction {
-	config := f.config
-	ctx := context.Background()
-
 	rawAuthData := &AuthData{}
 	cookieName := f.CookieName("auth_data")
-	err := config.cookieEncoding.Decode(cookieName, encodedAuthData, rawAuthData)
+	err := f.config.cookieEncoding.Decode(cookieName, encodedAuthData, rawAuthData)
 	if err != nil {
 		api.LogInfof("bad oidc cookie: %s, err: %v", encodedAuthData, err)
 		return &api.LocalResponse{Code: 403, Msg: "bad oidc cookie"}
 	}
+	return f.attachAuthData(headers, rawAuthData)
+}
+
+// loadSession returns nil AuthData if the session is expired or revoked
+func (f *filter) loadSession(encodedSessionID string) (*AuthData, api.ResultAction) {
+	cookieName := f.CookieName("session")
+	var id string
+	err := f.config.cookieEncoding.Decode(cookieName, encodedSessionID, &id)
+	if err != nil {
+		api.LogInfof("bad oidc cookie: %s, err: %v", encodedSessionID, err)
+		return nil, &api.LocalResponse{Code: 403, Msg: "bad oidc cookie"}
+	}
+
+	authData, err := f.config.sessionStore.Get(f.callbacks.Context(), id)
+	if err != nil {
+		api.LogErrorf("failed to get session: %v", err)
+		return nil, &api.LocalResponse{Code: 503, Msg: "failed to get session"}
+	}
+	if authData != nil {
+		// Don't reuse the ID of the expired session, so a new ID is generated after login
+		f.sessionID = id
+	}
+	return authData, nil
+}
+
+func (f *filter) attachAuthData(headers api.RequestHeaderMap, rawAuthData *AuthData) api.ResultAction {
+	config := f.config
+	ctx := f.callbacks.Context()
 
 	oauth2Token := rawAuthData.Oauth2Token
 	if f.refreshEnabled(oauth2Token) {
@@ -299,7 +349,7 @@ func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedAuthData string
 			}
 
 			rawAuthData.Oauth2Token = oauth2Token
-			f.authDataCookie, err = f.saveAuthDataAsCookie(ctx, rawAuthData)
+			f.authDataCookie, err = f.saveAuthData(ctx, rawAuthData)
 			if err != nil {
 				return &api.LocalResponse{Code: 503, Msg: "failed to save token"}
 			}
@@ -321,11 +371,154 @@ func (f *filter) attachInfo(headers api.RequestHeaderMap, encodedAuthData string
 	return api.Continue
 }
 
+func (f *filter) handleLogout(headers api.RequestHeaderMap) api.ResultAction {
+	config := f.config
+	var authData *AuthData
+	if config.sessionStore != nil {
+		session := headers.Cookie(f.CookieName("session"))
+		if session != nil {
+			var res api.ResultAction
+			// The cookies are cleared even if the session can't be loaded
+			authData, res = f.loadSession(session.Value)
+			if res == nil && authData != nil {
+				err := config.sessionStore.Delete(f.callbacks.Context(), f.sessionID)
+				if err != nil {
+					api.LogErrorf("failed to delete session: %v", err)
+					return &api.LocalResponse{Code: 503, Msg: "failed to delete session"}
+				}
+			}
+		}
+	} else {
+		cookie := headers.Cookie(f.CookieName("auth_data"))
+		if cookie != nil {
+			data := &AuthData{}
+			err := config.cookieEncoding.Decode(f.CookieName("auth_data"), cookie.Value, data)
+			if err == nil {
+				authData = data
+			}
+		}
+	}
+
+	hdr := http.Header{}
+	for _, key := range []string{"nonce", "auth_data", "session"} {
+		hdr.Add("Set-Cookie", f.newCookie(f.CookieName(key), "", -1).String())
+	}
+
+	location := config.Logout.PostLogoutRedirectUrl
+	if config.endSessionEndpoint != nil {
+		// RP-Initiated Logout: https://openid.net/specs/openid-connect-rpinitiated-1_0.html
+		u := *config.endSessionEndpoint
+		query := u.Query()
+		query.Set("client_id", config.ClientId)
+		if authData != nil && authData.IDToken != "" {
+			query.Set("id_token_hint", authData.IDToken)
+		}
+		if location != "" {
+			query.Set("post_logout_redirect_uri", location)
+		}
+		u.RawQuery = query.Encode()
+		location = u.String()
+	}
+
+	if location == "" {
+		return &api.LocalResponse{Code: 200, Msg: "logged out", Header: hdr}
+	}
+	hdr.Set("Location", location)
+	return &api.LocalResponse{Code: http.StatusFound, Header: hdr}
+}
+
+// handleBackchannelLogout handles the logout token sent by the OIDC Provider.
+// See https://openid.net/specs/openid-connect-backchannel-1_0.html
+func (f *filter) handleBackchannelLogout(rawLogoutToken string) api.ResultAction {
+	config := f.config
+	ctx := config.ctxWithClient(f.callbacks.Context())
+	// The verifier checks the signature, issuer, audience and expiry
+	logoutToken, err := config.verifier.Verify(ctx, rawLogoutToken)
+	if err != nil {
+		api.LogInfof("bad logout token: %v", err)
+		return &api.LocalResponse{Code: 400, Msg: "bad logout token"}
+	}
+
+	var claims struct {
+		Sid    string                     `json:"sid"`
+		Events map[string]json.RawMessage `json:"events"`
+	}
+	err = logoutToken.Claims(&claims)
+	if err != nil {
+		api.LogInfof("bad logout token: %v", err)
+		return &api.LocalResponse{Code: 400, Msg: "bad logout token"}
+	}
+	if _, ok := claims.Events[backchannelLogoutEvent]; !ok || logoutToken.Nonce != "" {
+		api.LogInfof("bad logout token, events: %v, nonce: %s", claims.Events, logoutToken.Nonce)
+		return &api.LocalResponse{Code: 400, Msg: "bad logout token"}
+	}
+
+	var key string
+	if claims.Sid != "" {
+		key = "sid:" + claims.Sid
+	} else if logoutToken.Subject != "" {
+		key = "sub:" + logoutToken.Subject
+	} else {
+		api.LogInfo("bad logout token, neither sid nor sub is given")
+		return &api.LocalResponse{Code: 400, Msg: "bad logout token"}
+	}
+
+	err = config.sessionStore.Revoke(ctx, key)
+	if err != nil {
+		api.LogErrorf("failed to revoke sessions: %v", err)
+		return &api.LocalResponse{Code: 503, Msg: "failed to revoke sessions"}
+	}
+
+	api.LogInfof("sessions revoked by back-channel logout, key: %s", key)
+	return &api.LocalResponse{Code: 200, Header: http.Header{"Cache-Control": []string{"no-store"}}}
+}
+
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	cookieName := f.CookieName("auth_data")
-	authData := headers.Cookie(cookieName)
-	if authData != nil {
-		return f.attachInfo(headers, authData.Value)
+	path := headers.URL().Path
+	if logout := f.config.BackchannelLogout; logout != nil && path == logout.Path {
+		if headers.Method() != http.MethodPost {
+			return &api.LocalResponse{Code: 405}
+		}
+		if endStream {
+			return &api.LocalResponse{Code: 400, Msg: "missing logout token"}
+		}
+		return api.WaitAllData
+	}
+	if logout := f.config.Logout; logout != nil && path == logout.Path {
+		return f.handleLogout(headers)
+	}
+	if bearer := f.config.BearerToken; bearer != nil {
+		// avoid the headers being forged by the client, whether the bearer token is used or not
+		for _, c := range bearer.ClaimsToHeaders {
+			headers.Del(c.Header)
+		}
+
+		token, ok := getBearerToken(headers)
+		if ok {
+			return f.handleBearerToken(headers, token)
+		}
+		if bearer.BearerOnly {
+			return bearerChallenge(401, "", "missing bearer token")
+		}
+	}
+
+	if f.config.sessionStore != nil {
+		session := headers.Cookie(f.CookieName("session"))
+		if session != nil {
+			authData, res := f.loadSession(session.Value)
+			if res != nil {
+				return res
+			}
+			if authData != nil {
+				return f.attachAuthData(headers, authData)
+			}
+			// The session is expired or revoked. Start a new login.
+		}
+	} else {
+		authData := headers.Cookie(f.CookieName("auth_data"))
+		if authData != nil {
+			return f.attachInfo(headers, authData.Value)
+		}
 	}
 
 	query := headers.URL().Query()
@@ -337,13 +530,64 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 	return f.handleCallback(headers, query)
 }
 
-func (f *filter) saveAuthDataAsCookie(ctx context.Context, authData *AuthData) (*http.Cookie, error) {
+// saveAuthData saves the auth data and returns the cookie to set
+func (f *filter) saveAuthData(ctx context.Context, authData *AuthData) (*http.Cookie, error) {
 	idToken, err := f.config.verifier.Verify(ctx, authData.IDToken)
 	if err != nil {
 		api.LogErrorf("bad authData: %v", err)
 		return nil, err
 	}
 
+	ttl := f.calculateTokenTTL(authData.Oauth2Token.Expiry, idToken.Expiry, f.refreshEnabled(authData.Oauth2Token))
+	if f.config.sessionStore != nil {
+		var links []string
+		if f.config.BackchannelLogout != nil {
+			// link the session so that it can be revoked by the logout token
+			var claims struct {
+				Sid string `json:"sid"`
+			}
+			_ = idToken.Claims(&claims)
+			links = append(links, "sub:"+idToken.Subject)
+			if claims.Sid != "" {
+				links = append(links, "sid:"+claims.Sid)
+			}
+		}
+		return f.saveAuthDataInSession(ctx, authData, links, ttl)
+	}
+	return f.saveAuthDataAsCookie(authData, ttl)
+}
+
+func (f *filter) saveAuthDataInSession(ctx context.Context, authData *AuthData, links []string, ttl int) (*http.Cookie, error) {
+	if f.sessionID == "" {
+		f.sessionID = newSessionID()
+	}
+	store := f.config.sessionStore
+	err := store.Set(ctx, f.sessionID, authData, time.Duration(ttl)*time.Second)
+	if err != nil {
+		api.LogErrorf("failed to save session: %v", err)
+		return nil, err
+	}
+	for _, key := range links {
+		err = store.Link(ctx, key, f.sessionID, time.Duration(ttl)*time.Second)
+		if err != nil {
+			api.LogErrorf("failed to link session: %v", err)
+			return nil, err
+		}
+	}
+
+	cookieName := f.CookieName("session")
+	encodedSessionID, err := f.config.cookieEncoding.Encode(cookieName, f.sessionID)
+	if err != nil {
+		api.LogErrorf("failed to encode cookie: %v", err)
+		return nil, err
+	}
+
+	cookie := f.newCookie(cookieName, encodedSessionID, ttl)
+	api.LogInfof("authData saved in session, client id: %s", f.config.ClientId)
+	return cookie, nil
+}
+
+func (f *filter) saveAuthDataAsCookie(authData *AuthData, ttl int) (*http.Cookie, error) {
 	cookieName := f.CookieName("auth_data")
 	encodedAuthData, err := f.config.cookieEncoding.Encode(cookieName, *authData)
 	if err != nil {
@@ -351,18 +595,24 @@ func (f *filter) saveAuthDataAsCookie(ctx context.Context, authData *AuthData) (
 		return nil, err
 	}
 
-	ttl := f.calculateTokenTTL(authData.Oauth2Token.Expiry, idToken.Expiry, f.refreshEnabled(authData.Oauth2Token))
-	cookie := &http.Cookie{
-		Name:     cookieName,
-		Value:    encodedAuthData,
-		MaxAge:   ttl,
-		HttpOnly: true,
-	}
+	cookie := f.newCookie(cookieName, encodedAuthData, ttl)
 
 	api.LogInfof("authData saved as cookie %+v, client id: %s", cookie, f.config.ClientId)
 	return cookie, nil
 }
 
+func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	// Only the back-channel logout request waits for the body
+	if data == nil {
+		return &api.LocalResponse{Code: 400, Msg: "missing logout token"}
+	}
+	form, err := url.ParseQuery(data.String())
+	if err != nil || form.Get("logout_token") == "" {
+		return &api.LocalResponse{Code: 400, Msg: "missing logout token"}
+	}
+	return f.handleBackchannelLogout(form.Get("logout_token"))
+}
+
 func (f *filter) EncodeHeaders(headers api.ResponseHeaderMap, endStream bool) api.ResultAction {
 	if f.authDataCookie != nil {
 		headers.Add("set-cookie", f.authDataCookie.String())
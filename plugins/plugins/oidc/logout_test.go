@@ -0,0 +1,324 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	oidctype "mosn.io/htnn/types/plugins/oidc"
+)
+
+func withClaims(token *oidc.IDToken, claims string) *oidc.IDToken {
+	val := reflect.ValueOf(token).Elem()
+	field := val.FieldByName("claims")
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().SetBytes([]byte(claims))
+	return token
+}
+
+func TestCookieAttributes(t *testing.T) {
+	conf := getCfg()
+	conf.Cookie = &oidctype.Cookie{
+		Domain:   "example.com",
+		Path:     "/",
+		Secure:   true,
+		SameSite: oidctype.Cookie_LAX,
+	}
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	resp := f.DecodeHeaders(hdr, true).(*api.LocalResponse)
+	assert.Equal(t, http.StatusFound, resp.Code)
+	cookie := resp.Header.Get("Set-Cookie")
+	assert.Contains(t, cookie, "; Path=/; Domain=example.com; Max-Age=3600; HttpOnly; Secure; SameSite=Lax")
+}
+
+func TestLogout(t *testing.T) {
+	endSessionEndpoint, _ := url.Parse("http://op.example.com/logout?x=y")
+	token := &oauth2.Token{AccessToken: "accessToken", Expiry: time.Now().Add(time.Hour)}
+	encodedAuthData, _ := getCfg().cookieEncoding.Encode("htnn_oidc_auth_data_id", AuthData{
+		IDToken:     "rawIDToken",
+		Oauth2Token: token,
+	})
+
+	tests := []struct {
+		name     string
+		conf     func(conf *config)
+		path     string
+		cookie   string
+		code     int
+		location string
+	}{
+		{
+			name: "not logout path",
+			path: "/echo",
+			code: http.StatusFound,
+		},
+		{
+			name: "without end session endpoint",
+			conf: func(conf *config) {
+				conf.endSessionEndpoint = nil
+			},
+			code: 200,
+		},
+		{
+			name: "post logout redirect url only",
+			conf: func(conf *config) {
+				conf.endSessionEndpoint = nil
+				conf.Logout.PostLogoutRedirectUrl = "http://127.0.0.1:10000/bye"
+			},
+			code:     http.StatusFound,
+			location: "http://127.0.0.1:10000/bye",
+		},
+		{
+			name:     "end session endpoint",
+			code:     http.StatusFound,
+			location: "http://op.example.com/logout?client_id=9119df09-b20b-4c08-ba08-72472dda2cd2&x=y",
+		},
+		{
+			name: "end session endpoint with id token hint",
+			conf: func(conf *config) {
+				conf.Logout.PostLogoutRedirectUrl = "http://127.0.0.1:10000/bye"
+			},
+			cookie:   "htnn_oidc_auth_data_id=" + encodedAuthData,
+			code:     http.StatusFound,
+			location: "http://op.example.com/logout?client_id=9119df09-b20b-4c08-ba08-72472dda2cd2&id_token_hint=rawIDToken&post_logout_redirect_uri=http%3A%2F%2F127.0.0.1%3A10000%2Fbye&x=y",
+		},
+		{
+			name:     "bad cookie",
+			cookie:   "htnn_oidc_auth_data_id=xxx",
+			code:     http.StatusFound,
+			location: "http://op.example.com/logout?client_id=9119df09-b20b-4c08-ba08-72472dda2cd2&x=y",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := getCfg()
+			conf.Logout = &oidctype.Logout{Path: "/logout"}
+			conf.endSessionEndpoint = endSessionEndpoint
+			if tt.conf != nil {
+				tt.conf(conf)
+			}
+			f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+			h := http.Header{}
+			path := tt.path
+			if path == "" {
+				path = "/logout?from=x"
+			}
+			h.Set(":path", path)
+			if tt.cookie != "" {
+				h.Set("cookie", tt.cookie)
+			}
+			resp := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true).(*api.LocalResponse)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.path != "" {
+				return
+			}
+
+			assert.Equal(t, tt.location, resp.Header.Get("Location"))
+			assert.Equal(t, []string{
+				"htnn_oidc_nonce_id=; Max-Age=0; HttpOnly",
+				"htnn_oidc_auth_data_id=; Max-Age=0; HttpOnly",
+				"htnn_oidc_session_id=; Max-Age=0; HttpOnly",
+			}, resp.Header.Values("Set-Cookie"))
+		})
+	}
+}
+
+func TestLogoutWithSession(t *testing.T) {
+	conf := getCfg()
+	conf.Logout = &oidctype.Logout{Path: "/logout"}
+	conf.sessionStore = newMemorySessionStore(&oidctype.MemorySessionStore{})
+	ctx := context.Background()
+	require.NoError(t, conf.sessionStore.Set(ctx, "id", &AuthData{IDToken: "rawIDToken"}, time.Hour))
+	encodedSessionID, _ := conf.cookieEncoding.Encode("htnn_oidc_session_id", "id")
+
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	h := http.Header{}
+	h.Set(":path", "/logout")
+	h.Set("cookie", "htnn_oidc_session_id="+encodedSessionID)
+	resp := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true).(*api.LocalResponse)
+	assert.Equal(t, 200, resp.Code)
+	data, err := conf.sessionStore.Get(ctx, "id")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestBackchannelLogout(t *testing.T) {
+	conf := getCfg()
+	conf.BackchannelLogout = &oidctype.BackchannelLogout{Path: "/backchannel_logout"}
+	conf.sessionStore = newMemorySessionStore(&oidctype.MemorySessionStore{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		method  string
+		body    string
+		noBody  bool
+		token   *oidc.IDToken
+		claims  string
+		err     error
+		code    int
+		revoked []string
+	}{
+		{
+			name:   "method not allowed",
+			method: "GET",
+			code:   405,
+		},
+		{
+			name: "missing logout token",
+			body: "token=xxx",
+			code: 400,
+		},
+		{
+			name:   "without body",
+			noBody: true,
+			code:   400,
+		},
+		{
+			name: "bad logout token",
+			body: "logout_token=xxx",
+			err:  assert.AnError,
+			code: 400,
+		},
+		{
+			name:   "missing events",
+			body:   "logout_token=xxx",
+			token:  &oidc.IDToken{Subject: "a"},
+			claims: `{"sub":"a"}`,
+			code:   400,
+		},
+		{
+			name:   "nonce is not allowed",
+			body:   "logout_token=xxx",
+			token:  &oidc.IDToken{Subject: "a", Nonce: "n"},
+			claims: `{"sub":"a","events":{"http://schemas.openid.net/event/backchannel-logout":{}}}`,
+			code:   400,
+		},
+		{
+			name:   "missing sid and sub",
+			body:   "logout_token=xxx",
+			token:  &oidc.IDToken{},
+			claims: `{"events":{"http://schemas.openid.net/event/backchannel-logout":{}}}`,
+			code:   400,
+		},
+		{
+			name:    "revoke by sid",
+			body:    "logout_token=xxx",
+			token:   &oidc.IDToken{Subject: "a"},
+			claims:  `{"sub":"a","sid":"s1","events":{"http://schemas.openid.net/event/backchannel-logout":{}}}`,
+			code:    200,
+			revoked: []string{"id1"},
+		},
+		{
+			name:    "revoke by sub",
+			body:    "logout_token=xxx",
+			token:   &oidc.IDToken{Subject: "a"},
+			claims:  `{"sub":"a","events":{"http://schemas.openid.net/event/backchannel-logout":{}}}`,
+			code:    200,
+			revoked: []string{"id1", "id2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for id, sid := range map[string]string{"id1": "s1", "id2": "s2", "id3": "s3"} {
+				require.NoError(t, conf.sessionStore.Set(ctx, id, &AuthData{}, time.Hour))
+				require.NoError(t, conf.sessionStore.Link(ctx, "sid:"+sid, id, time.Hour))
+			}
+			require.NoError(t, conf.sessionStore.Link(ctx, "sub:a", "id1", time.Hour))
+			require.NoError(t, conf.sessionStore.Link(ctx, "sub:a", "id2", time.Hour))
+
+			var token *oidc.IDToken
+			if tt.token != nil {
+				token = withClaims(tt.token, tt.claims)
+			}
+			patches := gomonkey.ApplyMethodReturn(conf.verifier, "Verify", token, tt.err)
+			defer patches.Reset()
+
+			f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+			h := http.Header{}
+			h.Set(":path", "/backchannel_logout")
+			method := tt.method
+			if method == "" {
+				method = "POST"
+			}
+			h.Set(":method", method)
+			hdr := envoy.NewRequestHeaderMap(h)
+			res := f.DecodeHeaders(hdr, false)
+			if res == api.WaitAllData {
+				var data api.BufferInstance
+				if !tt.noBody {
+					data = envoy.NewBufferInstance([]byte(tt.body))
+				}
+				res = f.DecodeRequest(hdr, data, nil)
+			}
+			resp := res.(*api.LocalResponse)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.code == 200 {
+				assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+			}
+
+			for _, id := range []string{"id1", "id2", "id3"} {
+				data, err := conf.sessionStore.Get(ctx, id)
+				require.NoError(t, err)
+				revoked := false
+				for _, r := range tt.revoked {
+					revoked = revoked || r == id
+				}
+				assert.Equal(t, revoked, data == nil, id)
+			}
+		})
+	}
+}
+
+func TestSessionLinkedForBackchannelLogout(t *testing.T) {
+	conf := getCfg()
+	conf.BackchannelLogout = &oidctype.BackchannelLogout{Path: "/backchannel_logout"}
+	conf.sessionStore = newMemorySessionStore(&oidctype.MemorySessionStore{})
+	ctx := context.Background()
+
+	idToken := withClaims(&oidc.IDToken{Subject: "a", Expiry: time.Now().Add(time.Hour)}, `{"sub":"a","sid":"s1"}`)
+	patches := gomonkey.ApplyMethodReturn(conf.verifier, "Verify", idToken, nil)
+	defer patches.Reset()
+
+	f := factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	cookie, err := f.saveAuthData(ctx, &AuthData{
+		IDToken:     "rawIDToken",
+		Oauth2Token: &oauth2.Token{AccessToken: "accessToken", Expiry: time.Now().Add(time.Hour)},
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(cookie.Name, "htnn_oidc_session_"))
+
+	require.NoError(t, conf.sessionStore.Revoke(ctx, "sid:s1"))
+	data, err := conf.sessionStore.Get(ctx, f.sessionID)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
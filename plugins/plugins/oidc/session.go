@@ -0,0 +1,242 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/redis/go-redis/v9"
+
+	oidctype "mosn.io/htnn/types/plugins/oidc"
+)
+
+const (
+	defaultSessionStoreCapacity = 100000
+	defaultSessionStorePrefix   = "htnn:oidc:session:"
+)
+
+// sessionStore keeps the auth data on the server side, so that only the session ID is stored in the cookie
+type sessionStore interface {
+	// Get returns nil if the session doesn't exist or is expired
+	Get(ctx context.Context, id string) (*AuthData, error)
+	Set(ctx context.Context, id string, data *AuthData, ttl time.Duration) error
+	Delete(ctx context.Context, id string) error
+	// Link associates the session with the key, like the `sid` or the `sub` of the ID token,
+	// so that the sessions can be revoked by the key
+	Link(ctx context.Context, key string, id string, ttl time.Duration) error
+	// Revoke deletes all the sessions associated with the key
+	Revoke(ctx context.Context, key string) error
+}
+
+func newSessionID() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+type memorySessionStore struct {
+	cache *ttlcache.Cache[string, *AuthData]
+
+	lock  sync.Mutex
+	links *ttlcache.Cache[string, []string]
+}
+
+// The memory session stores are shared among the configurations, so that the users are not
+// logged out when the configuration is changed.
+var memorySessionStores sync.Map
+
+func getMemorySessionStore(issuer string, cookieEntryID string, conf *oidctype.MemorySessionStore) *memorySessionStore {
+	key := fmt.Sprintf("%s|%s|%d", issuer, cookieEntryID, conf.GetCapacity())
+	if s, ok := memorySessionStores.Load(key); ok {
+		return s.(*memorySessionStore)
+	}
+	s, _ := memorySessionStores.LoadOrStore(key, newMemorySessionStore(conf))
+	return s.(*memorySessionStore)
+}
+
+func newMemorySessionStore(conf *oidctype.MemorySessionStore) *memorySessionStore {
+	capacity := conf.GetCapacity()
+	if capacity == 0 {
+		capacity = defaultSessionStoreCapacity
+	}
+	// The expired items are dropped when they are accessed or evicted due to the capacity,
+	// so we don't need to start the cleaner goroutine for each configuration.
+	return &memorySessionStore{
+		cache: ttlcache.New(
+			ttlcache.WithCapacity[string, *AuthData](uint64(capacity)),
+			ttlcache.WithDisableTouchOnHit[string, *AuthData](),
+		),
+		links: ttlcache.New(
+			ttlcache.WithCapacity[string, []string](uint64(capacity)),
+			ttlcache.WithDisableTouchOnHit[string, []string](),
+		),
+	}
+}
+
+func (s *memorySessionStore) Get(_ context.Context, id string) (*AuthData, error) {
+	item := s.cache.Get(id)
+	if item == nil {
+		return nil, nil
+	}
+	// copy it as the caller may modify the returned data
+	data := *item.Value()
+	return &data, nil
+}
+
+func (s *memorySessionStore) Set(_ context.Context, id string, data *AuthData, ttl time.Duration) error {
+	s.cache.Set(id, data, ttl)
+	return nil
+}
+
+func (s *memorySessionStore) Delete(_ context.Context, id string) error {
+	s.cache.Delete(id)
+	return nil
+}
+
+func (s *memorySessionStore) Link(_ context.Context, key string, id string, ttl time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var ids []string
+	if item := s.links.Get(key); item != nil {
+		ids = item.Value()
+		if slices.Contains(ids, id) {
+			return nil
+		}
+		// keep the link until the last session is expired
+		if left := time.Until(item.ExpiresAt()); left > ttl {
+			ttl = left
+		}
+	}
+	// the expired sessions are removed from the link when it's revoked
+	s.links.Set(key, append(slices.Clip(ids), id), ttl)
+	return nil
+}
+
+func (s *memorySessionStore) Revoke(_ context.Context, key string) error {
+	s.lock.Lock()
+	item := s.links.Get(key)
+	s.links.Delete(key)
+	s.lock.Unlock()
+
+	if item == nil {
+		return nil
+	}
+	for _, id := range item.Value() {
+		s.cache.Delete(id)
+	}
+	return nil
+}
+
+type redisSessionStore struct {
+	client *redis.Client
+	prefix string
+}
+
+func newRedisSessionStore(conf *oidctype.RedisSessionStore) *redisSessionStore {
+	opt := &redis.Options{
+		Addr:     conf.Address,
+		Username: conf.Username,
+		Password: conf.Password,
+	}
+	if conf.Tls {
+		opt.TLSConfig = &tls.Config{
+			InsecureSkipVerify: conf.TlsSkipVerify, // #nosec G402 -- configured by the user
+		}
+	}
+
+	prefix := conf.Prefix
+	if prefix == "" {
+		prefix = defaultSessionStorePrefix
+	}
+	return &redisSessionStore{
+		client: redis.NewClient(opt),
+		prefix: prefix,
+	}
+}
+
+func (s *redisSessionStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *redisSessionStore) Get(ctx context.Context, id string) (*AuthData, error) {
+	b, err := s.client.Get(ctx, s.prefix+id).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data := &AuthData{}
+	err = json.Unmarshal(b, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *redisSessionStore) Set(ctx context.Context, id string, data *AuthData, ttl time.Duration) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.prefix+id, b, ttl).Err()
+}
+
+func (s *redisSessionStore) Delete(ctx context.Context, id string) error {
+	return s.client.Del(ctx, s.prefix+id).Err()
+}
+
+func (s *redisSessionStore) linkKey(key string) string {
+	return s.prefix + "link:" + key
+}
+
+func (s *redisSessionStore) Link(ctx context.Context, key string, id string, ttl time.Duration) error {
+	linkKey := s.linkKey(key)
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, linkKey, id)
+		// The sessions of the same user usually have the similar TTL, so we simply use the latest one.
+		// The link is extended when the session is refreshed.
+		pipe.Expire(ctx, linkKey, ttl)
+		return nil
+	})
+	return err
+}
+
+func (s *redisSessionStore) Revoke(ctx context.Context, key string) error {
+	linkKey := s.linkKey(key)
+	ids, err := s.client.SMembers(ctx, linkKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, s.prefix+id)
+	}
+	keys = append(keys, linkKey)
+	return s.client.Del(ctx, keys...).Err()
+}
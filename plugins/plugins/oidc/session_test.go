@@ -0,0 +1,201 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	oidctype "mosn.io/htnn/types/plugins/oidc"
+)
+
+func TestSessionStore(t *testing.T) {
+	s, err := miniredis.Run()
+	require.NoError(t, err)
+	defer s.Close()
+
+	stores := map[string]sessionStore{
+		"memory": newMemorySessionStore(&oidctype.MemorySessionStore{}),
+		"redis":  newRedisSessionStore(&oidctype.RedisSessionStore{Address: s.Addr()}),
+	}
+	ctx := context.Background()
+	for name, store := range stores {
+		data, err := store.Get(ctx, "id")
+		require.NoError(t, err, name)
+		assert.Nil(t, data, name)
+
+		expiry := time.Now().Add(time.Hour).Round(time.Second)
+		require.NoError(t, store.Set(ctx, "id", &AuthData{
+			IDToken:      "idToken",
+			Oauth2Token:  &oauth2.Token{AccessToken: "accessToken", RefreshToken: "refreshToken", Expiry: expiry},
+			UserInfoJSON: "{}",
+		}, time.Hour), name)
+		data, err = store.Get(ctx, "id")
+		require.NoError(t, err, name)
+		assert.Equal(t, "idToken", data.IDToken, name)
+		assert.Equal(t, "accessToken", data.Oauth2Token.AccessToken, name)
+		assert.Equal(t, "refreshToken", data.Oauth2Token.RefreshToken, name)
+		assert.True(t, expiry.Equal(data.Oauth2Token.Expiry), name)
+		assert.Equal(t, "{}", data.UserInfoJSON, name)
+
+		require.NoError(t, store.Delete(ctx, "id"), name)
+		data, err = store.Get(ctx, "id")
+		require.NoError(t, err, name)
+		assert.Nil(t, data, name)
+
+		for _, id := range []string{"id1", "id2", "id3"} {
+			require.NoError(t, store.Set(ctx, id, &AuthData{IDToken: id}, time.Hour), name)
+		}
+		require.NoError(t, store.Link(ctx, "sub:a", "id1", time.Hour), name)
+		require.NoError(t, store.Link(ctx, "sub:a", "id2", time.Hour), name)
+		require.NoError(t, store.Link(ctx, "sub:a", "id2", time.Hour), name)
+		require.NoError(t, store.Link(ctx, "sub:b", "id3", time.Hour), name)
+		require.NoError(t, store.Revoke(ctx, "sub:a"), name)
+		require.NoError(t, store.Revoke(ctx, "sub:c"), name)
+		for id, exist := range map[string]bool{"id1": false, "id2": false, "id3": true} {
+			data, err = store.Get(ctx, id)
+			require.NoError(t, err, name)
+			assert.Equal(t, exist, data != nil, name+" "+id)
+		}
+	}
+
+	require.NoError(t, stores["redis"].Set(ctx, "id", &AuthData{}, time.Hour))
+	assert.Equal(t, time.Hour, s.TTL("htnn:oidc:session:id"))
+
+	s.Close()
+	_, err = stores["redis"].Get(ctx, "id")
+	assert.Error(t, err)
+}
+
+func TestMemorySessionStoreShared(t *testing.T) {
+	conf := &oidctype.MemorySessionStore{}
+	store := getMemorySessionStore("https://op.example.com", "client", conf)
+	ctx := context.Background()
+	require.NoError(t, store.Set(ctx, "id", &AuthData{IDToken: "idToken"}, time.Hour))
+
+	// the sessions are kept when the configuration is changed
+	data, err := getMemorySessionStore("https://op.example.com", "client", conf).Get(ctx, "id")
+	require.NoError(t, err)
+	assert.Equal(t, "idToken", data.IDToken)
+
+	data, err = getMemorySessionStore("https://op.example.com", "another", conf).Get(ctx, "id")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestSession(t *testing.T) {
+	conf := getCfg()
+	conf.sessionStore = newMemorySessionStore(&oidctype.MemorySessionStore{})
+
+	verifier := oauth2.GenerateVerifier()
+	state := generateState(verifier, conf.ClientSecret, "https://127.0.0.1:2379/x?y=1")
+	token := (&oauth2.Token{
+		AccessToken:  "accessToken",
+		RefreshToken: "refreshToken",
+		Expiry:       time.Now().Add(time.Hour),
+	}).WithExtra(map[string]interface{}{
+		// a large id token which can't be stored in the cookie
+		"id_token": strings.Repeat("x", 8192),
+	})
+	nonce, _ := conf.cookieEncoding.Encode("htnn_oidc_nonce_id", "xxx")
+
+	patches := gomonkey.ApplyMethodReturn(conf.oauth2Config, "Exchange", token, nil)
+	patches.ApplyMethodReturn(conf.verifier, "Verify", &oidc.IDToken{
+		Nonce: "xxx", Expiry: time.Now().Add(2 * time.Hour),
+	}, nil)
+	patches.ApplyMethodReturn(conf.oidcProvider, "UserInfo", getUserinfo(), nil)
+	defer patches.Reset()
+
+	send := func(cookie string, path string) (*filter, api.ResultAction, api.RequestHeaderMap) {
+		cb := envoy.NewFilterCallbackHandler()
+		f := factory(conf, cb).(*filter)
+		h := http.Header{}
+		h.Set(":path", path)
+		h.Set("cookie", cookie)
+		hdr := envoy.NewRequestHeaderMap(h)
+		return f, f.DecodeHeaders(hdr, true), hdr
+	}
+
+	// login
+	_, res, _ := send("htnn_oidc_nonce_id="+nonce, "/echo?code=123&state="+state)
+	resp := res.(*api.LocalResponse)
+	require.Equal(t, http.StatusFound, resp.Code)
+	cookie := resp.Header.Get("Set-Cookie")
+	assert.True(t, strings.HasPrefix(cookie, "htnn_oidc_session_id="), cookie)
+	assert.Contains(t, cookie, "Max-Age=7199;")
+	assert.Less(t, len(cookie), 512)
+	sessionCookie := strings.Split(cookie, ";")[0]
+
+	var sessionID string
+	require.NoError(t, conf.cookieEncoding.Decode("htnn_oidc_session_id",
+		strings.SplitN(sessionCookie, "=", 2)[1], &sessionID))
+
+	// access with the session
+	tkSrc := &mockTokenSource{}
+	patches.ApplyMethodReturn(conf.oauth2Config, "TokenSource", tkSrc)
+	_, res, hdr := send(sessionCookie, "/echo")
+	assert.Equal(t, api.Continue, res)
+	authz, _ := hdr.Get("authorization")
+	assert.Equal(t, "Bearer accessToken", authz)
+
+	// the refreshed token is persisted in the session
+	data, _ := conf.sessionStore.Get(context.Background(), sessionID)
+	data.Oauth2Token.Expiry = time.Now().Add(-time.Hour)
+	conf.sessionStore.Set(context.Background(), sessionID, data, time.Hour)
+	patches.ApplyMethodReturn(tkSrc, "Token", &oauth2.Token{
+		AccessToken:  "accessToken2",
+		RefreshToken: "refreshToken",
+		Expiry:       time.Now().Add(time.Hour),
+	}, nil)
+	f, res, hdr := send(sessionCookie, "/echo")
+	assert.Equal(t, api.Continue, res)
+	authz, _ = hdr.Get("authorization")
+	assert.Equal(t, "Bearer accessToken2", authz)
+	data, _ = conf.sessionStore.Get(context.Background(), sessionID)
+	assert.Equal(t, "accessToken2", data.Oauth2Token.AccessToken)
+	respHdr := envoy.NewResponseHeaderMap(http.Header{})
+	f.EncodeHeaders(respHdr, true)
+	cookie, _ = respHdr.Get("Set-Cookie")
+	assert.True(t, strings.HasPrefix(cookie, "htnn_oidc_session_id="), cookie)
+
+	// revoke the session
+	require.NoError(t, conf.sessionStore.Delete(context.Background(), sessionID))
+	_, res, _ = send(sessionCookie, "/echo")
+	resp = res.(*api.LocalResponse)
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), "htnn_oidc_nonce_id=")
+
+	// the forged session
+	_, res, _ = send("htnn_oidc_session_id=xxx", "/echo")
+	resp = res.(*api.LocalResponse)
+	assert.Equal(t, 403, resp.Code)
+
+	// the auth data cookie is ignored
+	_, res, _ = send("htnn_oidc_auth_data_id=xxx", "/echo")
+	resp = res.(*api.LocalResponse)
+	assert.Equal(t, http.StatusFound, resp.Code)
+}
@@ -0,0 +1,175 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/open-policy-agent/opa/bundle"
+	"github.com/open-policy-agent/opa/rego"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/request"
+	"mosn.io/htnn/types/plugins/opa"
+)
+
+const (
+	defaultBundlePollingInterval = 60 * time.Second
+	defaultBundleTimeout         = 10 * time.Second
+)
+
+func prepareQuery(ctx context.Context, policy string, opts ...func(*rego.Rego)) (rego.PreparedEvalQuery, error) {
+	opts = append(opts, rego.Query(fmt.Sprintf(`
+            allow = data.%[1]s.allow;
+            custom_response = object.get(data.%[1]s, "custom_response", null)
+        `, policy)))
+	return rego.New(opts...).PrepareForEval(ctx)
+}
+
+// bundleLoader loads the bundle and reloads it periodically
+type bundleLoader struct {
+	conf   *opa.Bundle
+	policy string
+	client *http.Client
+	etag   string
+
+	query atomic.Pointer[rego.PreparedEvalQuery]
+	done  chan struct{}
+}
+
+func newBundleLoader(conf *opa.Bundle) (*bundleLoader, error) {
+	l := &bundleLoader{
+		conf: conf,
+		// the package path like `httpbin/authz` is referred as `data.httpbin.authz`
+		policy: strings.ReplaceAll(conf.Policy, "/", "."),
+		done:   make(chan struct{}),
+	}
+	if conf.GetUrl() != "" {
+		timeout := defaultBundleTimeout
+		if conf.Timeout != nil {
+			timeout = conf.Timeout.AsDuration()
+		}
+		client, err := request.NewClient(request.ClientOptions{
+			Name:           opa.Name,
+			Timeout:        timeout,
+			TLS:            request.NewTLSOptions(conf.Tls),
+			Retry:          request.NewRetryPolicy(conf.Retry),
+			CircuitBreaker: request.NewCircuitBreakerPolicy(conf.CircuitBreaker),
+		})
+		if err != nil {
+			return nil, err
+		}
+		l.client = client
+	}
+
+	err := l.load(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *bundleLoader) load(ctx context.Context) error {
+	var opt func(*rego.Rego)
+	var etag string
+	if path := l.conf.GetPath(); path != "" {
+		opt = rego.LoadBundle(path)
+	} else {
+		b, tag, err := l.download(ctx)
+		if err != nil {
+			return err
+		}
+		if b == nil {
+			// not modified
+			return nil
+		}
+		opt = rego.ParsedBundle("bundle", b)
+		etag = tag
+	}
+
+	query, err := prepareQuery(ctx, l.policy, opt)
+	if err != nil {
+		return err
+	}
+	l.query.Store(&query)
+	l.etag = etag
+	return nil
+}
+
+// download returns nil bundle if the bundle is not modified
+func (l *bundleLoader) download(ctx context.Context) (*bundle.Bundle, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.conf.GetUrl(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if l.etag != "" {
+		req.Header.Set("If-None-Match", l.etag)
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected status code %d when downloading bundle", resp.StatusCode)
+	}
+
+	b, err := bundle.NewReader(resp.Body).Read()
+	if err != nil {
+		return nil, "", err
+	}
+	return &b, resp.Header.Get("ETag"), nil
+}
+
+func (l *bundleLoader) Start() {
+	interval := defaultBundlePollingInterval
+	if l.conf.PollingInterval != nil {
+		interval = l.conf.PollingInterval.AsDuration()
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// Keep using the previous bundle if the reload fails
+				err := l.load(context.Background())
+				if err != nil {
+					api.LogErrorf("failed to reload OPA bundle: %v", err)
+				}
+			case <-l.done:
+				return
+			}
+		}
+	}()
+}
+
+func (l *bundleLoader) Stop() {
+	close(l.done)
+}
+
+func (l *bundleLoader) Query() *rego.PreparedEvalQuery {
+	return l.query.Load()
+}
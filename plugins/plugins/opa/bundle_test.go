@@ -0,0 +1,173 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/opa"
+)
+
+const bundlePolicy = `package httpbin.authz
+
+import input.request
+
+default allow = false
+
+allow {
+	request.method == "%s"
+}
+`
+
+func policyWithMethod(method string) string {
+	return fmt.Sprintf(bundlePolicy, method)
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0600,
+			Size: int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func decodeWithConfig(t *testing.T, c *config, method string) api.ResultAction {
+	f := factory(c, envoy.NewFilterCallbackHandler())
+	hdr := envoy.NewRequestHeaderMap(http.Header{})
+	hdr.Set(":method", method)
+	hdr.Set(":path", "/")
+	return f.DecodeHeaders(hdr, true)
+}
+
+func TestBundleFromPath(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "authz.rego")
+	require.NoError(t, os.WriteFile(file, []byte(policyWithMethod("GET")), 0600))
+
+	c := &config{
+		CustomConfig: opa.CustomConfig{
+			Config: opa.Config{
+				ConfigType: &opa.Config_Bundle{
+					Bundle: &opa.Bundle{
+						Source: &opa.Bundle_Path{Path: dir},
+						Policy: "httpbin/authz",
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Init(nil))
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "GET"))
+	assert.Equal(t, 403, decodeWithConfig(t, c, "POST").(*api.LocalResponse).Code)
+
+	require.NoError(t, os.WriteFile(file, []byte(policyWithMethod("POST")), 0600))
+	require.NoError(t, c.bundleLoader.load(context.Background()))
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "POST"))
+
+	// keep the previous bundle if the new one is bad
+	require.NoError(t, os.WriteFile(file, []byte("package httpbin.authz\nimport"), 0600))
+	assert.Error(t, c.bundleLoader.load(context.Background()))
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "POST"))
+
+	// bad bundle is rejected
+	c = &config{
+		CustomConfig: opa.CustomConfig{
+			Config: opa.Config{
+				ConfigType: &opa.Config_Bundle{
+					Bundle: &opa.Bundle{
+						Source: &opa.Bundle_Path{Path: dir},
+						Policy: "httpbin/authz",
+					},
+				},
+			},
+		},
+	}
+	assert.Error(t, c.Init(nil))
+}
+
+func TestBundleFromURL(t *testing.T) {
+	var policy atomic.Value
+	policy.Store(policyWithMethod("GET"))
+	var downloaded atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := policy.Load().(string)
+		etag := fmt.Sprintf(`"%d"`, len(p))
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloaded.Add(1)
+		w.Header().Set("ETag", etag)
+		w.Write(tarball(t, map[string]string{
+			"/httpbin/authz/policy.rego": p,
+		}))
+	}))
+	defer server.Close()
+
+	c := &config{
+		CustomConfig: opa.CustomConfig{
+			Config: opa.Config{
+				ConfigType: &opa.Config_Bundle{
+					Bundle: &opa.Bundle{
+						Source: &opa.Bundle_Url{Url: server.URL + "/bundle.tar.gz"},
+						Policy: "httpbin/authz",
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Init(nil))
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "GET"))
+
+	// not modified
+	require.NoError(t, c.bundleLoader.load(context.Background()))
+	assert.Equal(t, int32(1), downloaded.Load())
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "GET"))
+
+	policy.Store(policyWithMethod("DELETE"))
+	require.NoError(t, c.bundleLoader.load(context.Background()))
+	assert.Equal(t, int32(2), downloaded.Load())
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "DELETE"))
+	assert.Equal(t, 403, decodeWithConfig(t, c, "GET").(*api.LocalResponse).Code)
+
+	server.Close()
+	assert.Error(t, c.bundleLoader.load(context.Background()))
+	assert.Equal(t, api.Continue, decodeWithConfig(t, c, "DELETE"))
+}
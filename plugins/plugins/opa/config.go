@@ -19,12 +19,14 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"runtime"
 	"time"
 
 	"github.com/open-policy-agent/opa/rego"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/pkg/request"
 	"mosn.io/htnn/types/plugins/opa"
 )
 
@@ -49,6 +51,9 @@ type config struct {
 
 	client *http.Client
 	query  rego.PreparedEvalQuery
+
+	bundleLoader    *bundleLoader
+	decisionLogSink decisionLogSink
 }
 
 var (
@@ -56,6 +61,36 @@ var (
 )
 
 func (conf *config) Init(cb api.ConfigCallbackHandler) error {
+	if conf.DecisionLog != nil {
+		sink, err := newDecisionLogSink(conf.DecisionLog)
+		if err != nil {
+			return err
+		}
+		conf.decisionLogSink = sink
+	}
+
+	err := conf.initPolicy()
+	if err != nil {
+		if conf.decisionLogSink != nil {
+			conf.decisionLogSink.Close()
+		}
+		return err
+	}
+
+	if conf.bundleLoader != nil || conf.decisionLogSink != nil {
+		runtime.SetFinalizer(conf, func(conf *config) {
+			if conf.bundleLoader != nil {
+				conf.bundleLoader.Stop()
+			}
+			if conf.decisionLogSink != nil {
+				conf.decisionLogSink.Close()
+			}
+		})
+	}
+	return nil
+}
+
+func (conf *config) initPolicy() error {
 	remote := conf.GetRemote()
 	if remote != nil {
 		var timeout time.Duration
@@ -64,7 +99,28 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		} else {
 			timeout = 200 * time.Millisecond
 		}
-		conf.client = &http.Client{Timeout: timeout}
+		client, err := request.NewClient(request.ClientOptions{
+			Name:           opa.Name,
+			Timeout:        timeout,
+			TLS:            request.NewTLSOptions(remote.Tls),
+			Retry:          request.NewRetryPolicy(remote.Retry),
+			CircuitBreaker: request.NewCircuitBreakerPolicy(remote.CircuitBreaker),
+		})
+		if err != nil {
+			return err
+		}
+		conf.client = client
+		return nil
+	}
+
+	bundle := conf.GetBundle()
+	if bundle != nil {
+		loader, err := newBundleLoader(bundle)
+		if err != nil {
+			return err
+		}
+		loader.Start()
+		conf.bundleLoader = loader
 		return nil
 	}
 
@@ -75,13 +131,17 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 
 	ctx := context.Background()
 
-	query, _ := rego.New(
-		rego.Query(fmt.Sprintf(`
-            allow = data.%[1]s.allow;
-            custom_response = object.get(data.%[1]s, "custom_response", null)
-        `, policy)),
+	query, _ := prepareQuery(ctx, policy,
 		rego.Module(fmt.Sprintf("%s.rego", policy), module),
-	).PrepareForEval(ctx)
+	)
 	conf.query = query
 	return nil
 }
+
+// preparedQuery returns the query to evaluate the policy locally
+func (conf *config) preparedQuery() *rego.PreparedEvalQuery {
+	if conf.bundleLoader != nil {
+		return conf.bundleLoader.Query()
+	}
+	return &conf.query
+}
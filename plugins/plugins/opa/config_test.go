@@ -158,6 +158,69 @@ func TestBadConfig(t *testing.T) {
 			}`,
 			err: "rego_parse_error",
 		},
+		{
+			name: "bundle without source",
+			input: `{
+				"bundle": {
+					"policy": "authz"
+				}
+			}`,
+			err: "invalid Bundle.Source: value is required",
+		},
+		{
+			name: "empty path in bundle",
+			input: `{
+				"bundle": {
+					"path": "",
+					"policy": "authz"
+				}
+			}`,
+			err: "invalid Bundle: either path or url is required",
+		},
+		{
+			name: "bad url in bundle",
+			input: `{
+				"bundle": {
+					"url": "/bundle.tar.gz",
+					"policy": "authz"
+				}
+			}`,
+			err: "invalid Bundle.Url: value must be absolute",
+		},
+		{
+			name: "empty policy in bundle",
+			input: `{
+				"bundle": {
+					"path": "/etc/opa",
+					"policy": ""
+				}
+			}`,
+			err: "invalid Bundle.Policy: value length must be at least 1 runes",
+		},
+		{
+			name: "decision log without sink",
+			input: `{
+				"local": {
+					"text": "package test\ndefault allow = true"
+				},
+				"decisionLog": {}
+			}`,
+			err: "invalid DecisionLog.Sink: value is required",
+		},
+		{
+			name: "bad url in http sink",
+			input: `{
+				"local": {
+					"text": "package test\ndefault allow = true"
+				},
+				"decisionLog": {
+					"http": {
+						"url": "127.0.0.1:8080"
+					}
+				}
+			}`,
+			err: "invalid HttpSink.Url: value must be a valid URI",
+		},
 		{
 			name: "invalid timeout",
 			input: `{
@@ -0,0 +1,187 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/pkg/request"
+	"mosn.io/htnn/types/plugins/opa"
+)
+
+const (
+	defaultDecisionLogFlushInterval = 1 * time.Second
+	defaultDecisionLogBatchSize     = 100
+	defaultDecisionLogTimeout       = 3 * time.Second
+)
+
+type decisionLog struct {
+	Timestamp time.Time `json:"timestamp"`
+	// The SHA-256 hash of the input, so the input itself, which may contain credentials, is not logged
+	InputHash string `json:"input_hash"`
+	Consumer  string `json:"consumer,omitempty"`
+	Allow     bool   `json:"allow"`
+	Error     string `json:"error,omitempty"`
+	LatencyNs int64  `json:"latency_ns"`
+}
+
+// decisionLogSink is where the decision logs go
+type decisionLogSink interface {
+	// Write should not block the request
+	Write(log *decisionLog)
+	Close()
+}
+
+func newDecisionLogSink(conf *opa.DecisionLog) (decisionLogSink, error) {
+	if httpConf := conf.GetHttp(); httpConf != nil {
+		return newHTTPSink(httpConf)
+	}
+	return &logSink{}, nil
+}
+
+type logSink struct{}
+
+func (s *logSink) Write(log *decisionLog) {
+	b, _ := json.Marshal(log)
+	api.LogInfof("OPA decision log: %s", b)
+}
+
+func (s *logSink) Close() {}
+
+// httpSink sends the decision logs in batches. The logs are dropped if they can't be sent in time.
+type httpSink struct {
+	url       string
+	client    *http.Client
+	interval  time.Duration
+	batchSize int
+
+	logs chan *decisionLog
+	done chan struct{}
+}
+
+func newHTTPSink(conf *opa.HttpSink) (*httpSink, error) {
+	timeout := defaultDecisionLogTimeout
+	if conf.Timeout != nil {
+		timeout = conf.Timeout.AsDuration()
+	}
+	client, err := request.NewClient(request.ClientOptions{
+		Name:           opa.Name,
+		Timeout:        timeout,
+		TLS:            request.NewTLSOptions(conf.Tls),
+		Retry:          request.NewRetryPolicy(conf.Retry),
+		CircuitBreaker: request.NewCircuitBreakerPolicy(conf.CircuitBreaker),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultDecisionLogFlushInterval
+	if conf.FlushInterval != nil {
+		interval = conf.FlushInterval.AsDuration()
+	}
+	batchSize := int(conf.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultDecisionLogBatchSize
+	}
+
+	s := &httpSink{
+		url:       conf.Url,
+		client:    client,
+		interval:  interval,
+		batchSize: batchSize,
+		logs:      make(chan *decisionLog, batchSize*10),
+		done:      make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *httpSink) Write(log *decisionLog) {
+	select {
+	case s.logs <- log:
+	default:
+		api.LogWarnf("OPA decision log dropped as the buffer is full")
+	}
+}
+
+func (s *httpSink) Close() {
+	close(s.done)
+}
+
+func (s *httpSink) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	batch := make([]*decisionLog, 0, s.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		err := s.send(batch)
+		if err != nil {
+			api.LogErrorf("failed to send %d OPA decision logs: %v", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case log := <-s.logs:
+			batch = append(batch, log)
+			if len(batch) >= s.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.done:
+			for {
+				select {
+				case log := <-s.logs:
+					batch = append(batch, log)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *httpSink) send(logs []*decisionLog) error {
+	b, err := json.Marshal(logs)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/types/plugins/opa"
+)
+
+type fakeConsumer struct {
+	name string
+}
+
+func (c *fakeConsumer) Name() string {
+	return c.name
+}
+
+func (c *fakeConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
+type sliceSink struct {
+	logs []*decisionLog
+}
+
+func (s *sliceSink) Write(log *decisionLog) {
+	s.logs = append(s.logs, log)
+}
+
+func (s *sliceSink) Close() {}
+
+func TestRequestBodyAndConsumer(t *testing.T) {
+	c := &config{
+		CustomConfig: opa.CustomConfig{
+			Config: opa.Config{
+				ConfigType: &opa.Config_Local{
+					Local: &opa.Local{
+						Text: `package test
+						import input.request
+						default allow = false
+						allow {
+							input.consumer == "rick"
+							request.parsed_body.user == "rick"
+							request.parsed_body.id == 9007199254740993
+							contains(request.body, "rick")
+						}`,
+					},
+				},
+				WithRequestBody: true,
+			},
+		},
+	}
+	require.NoError(t, c.Init(nil))
+	sink := &sliceSink{}
+	c.decisionLogSink = sink
+
+	cb := envoy.NewFilterCallbackHandler()
+	cb.SetConsumer(&fakeConsumer{name: "rick"})
+	check := func(body string, ct string) api.ResultAction {
+		f := factory(c, cb)
+		hdr := envoy.NewRequestHeaderMap(http.Header{})
+		hdr.Set(":method", "POST")
+		hdr.Set(":path", "/")
+		hdr.Set("content-type", ct)
+		if body == "" {
+			return f.DecodeHeaders(hdr, true)
+		}
+		res := f.DecodeHeaders(hdr, false)
+		require.Equal(t, api.WaitAllData, res)
+		return f.DecodeRequest(hdr, envoy.NewBufferInstance([]byte(body)), nil)
+	}
+
+	assert.Equal(t, api.Continue, check(`{"user":"rick","id":9007199254740993}`, "application/json"))
+	assert.Equal(t, 403, check(`{"user":"rick","id":9007199254740992}`, "application/json").(*api.LocalResponse).Code)
+	assert.Equal(t, 403, check(`{"user":"rick","id":9007199254740993}`, "text/plain").(*api.LocalResponse).Code)
+	assert.Equal(t, 403, check(`{"user":"rick"`, "application/json").(*api.LocalResponse).Code)
+	assert.Equal(t, 403, check("", "application/json").(*api.LocalResponse).Code)
+
+	require.Len(t, sink.logs, 5)
+	log := sink.logs[0]
+	assert.True(t, log.Allow)
+	assert.Equal(t, "rick", log.Consumer)
+	assert.Len(t, log.InputHash, 64)
+	assert.Greater(t, log.LatencyNs, int64(0))
+	assert.False(t, sink.logs[1].Allow)
+	assert.NotEqual(t, log.InputHash, sink.logs[1].InputHash)
+}
+
+func TestDecisionLogError(t *testing.T) {
+	c := &config{
+		CustomConfig: opa.CustomConfig{
+			Config: opa.Config{
+				ConfigType: &opa.Config_Local{
+					Local: &opa.Local{
+						Text: `package test
+						default allow = "a"`,
+					},
+				},
+				DecisionLog: &opa.DecisionLog{
+					Sink: &opa.DecisionLog_Log{Log: &opa.LogSink{}},
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Init(nil))
+	_, ok := c.decisionLogSink.(*logSink)
+	assert.True(t, ok)
+	sink := &sliceSink{}
+	c.decisionLogSink = sink
+
+	res := decodeWithConfig(t, c, "GET")
+	assert.Equal(t, 503, res.(*api.LocalResponse).Code)
+	require.Len(t, sink.logs, 1)
+	assert.False(t, sink.logs[0].Allow)
+	assert.Equal(t, "unexpected type for 'allow' binding in OPA result", sink.logs[0].Error)
+	assert.Empty(t, sink.logs[0].Consumer)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var lock sync.Mutex
+	var batches [][]*decisionLog
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var logs []*decisionLog
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&logs))
+		lock.Lock()
+		batches = append(batches, logs)
+		lock.Unlock()
+	}))
+	defer server.Close()
+	getBatches := func() [][]*decisionLog {
+		lock.Lock()
+		defer lock.Unlock()
+		return batches
+	}
+
+	sink, err := newHTTPSink(&opa.HttpSink{
+		Url:           server.URL,
+		BatchSize:     2,
+		FlushInterval: durationpb.New(50 * time.Millisecond),
+	})
+	require.NoError(t, err)
+
+	// flush when the batch is full
+	sink.Write(&decisionLog{InputHash: "1", Allow: true})
+	sink.Write(&decisionLog{InputHash: "2"})
+	assert.Eventually(t, func() bool {
+		return len(getBatches()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "1", getBatches()[0][0].InputHash)
+	assert.True(t, getBatches()[0][0].Allow)
+	assert.Len(t, getBatches()[0], 2)
+
+	// flush periodically
+	sink.Write(&decisionLog{InputHash: "3"})
+	assert.Eventually(t, func() bool {
+		return len(getBatches()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, getBatches()[1], 1)
+
+	// flush when closed
+	sink.Write(&decisionLog{InputHash: "4"})
+	sink.Close()
+	assert.Eventually(t, func() bool {
+		return len(getBatches()) == 3
+	}, time.Second, 10*time.Millisecond)
+}
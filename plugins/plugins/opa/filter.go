@@ -17,11 +17,14 @@ package opa
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/textproto"
 	"strings"
+	"time"
 
 	"github.com/open-policy-agent/opa/rego"
 
@@ -73,7 +76,7 @@ func mapStrsToMapStr(strs map[string][]string) map[string]string {
 	return m
 }
 
-func (f *filter) buildInput(header api.RequestHeaderMap) map[string]interface{} {
+func (f *filter) buildInput(header api.RequestHeaderMap, body []byte) map[string]interface{} {
 	uri := header.URL()
 	headers := request.GetHeaders(header)
 	req := map[string]interface{}{
@@ -88,11 +91,29 @@ func (f *filter) buildInput(header api.RequestHeaderMap) map[string]interface{}
 	if uri.RawQuery != "" {
 		req["query"] = mapStrsToMapStr(uri.Query())
 	}
+	if body != nil {
+		req["body"] = string(body)
+		ct, _ := header.Get("content-type")
+		if strings.Contains(ct, "json") {
+			var parsed interface{}
+			dec := json.NewDecoder(bytes.NewReader(body))
+			dec.UseNumber()
+			if err := dec.Decode(&parsed); err == nil {
+				req["parsed_body"] = parsed
+			} else {
+				api.LogInfof("failed to parse body as JSON: %v", err)
+			}
+		}
+	}
 
+	input := map[string]interface{}{
+		"request": req,
+	}
+	if consumer := f.callbacks.GetConsumer(); consumer != nil {
+		input["consumer"] = consumer.Name()
+	}
 	return map[string]interface{}{
-		"input": map[string]interface{}{
-			"request": req,
-		},
+		"input": input,
 	}
 }
 
@@ -106,7 +127,12 @@ func (f *filter) isAllowed(input map[string]interface{}) (Result, error) {
 
 		path := remote.GetUrl() + "/v1/data/" + remote.GetPolicy()
 		api.LogInfof("send request to opa: %s, param: %s", path, params)
-		resp, err := f.config.client.Post(path, "application/json", bytes.NewReader(params))
+		req, err := http.NewRequestWithContext(f.callbacks.Context(), http.MethodPost, path, bytes.NewReader(params))
+		if err != nil {
+			return Result{Allow: false}, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := f.config.client.Do(req)
 		if err != nil {
 			return Result{Allow: false}, err
 		}
@@ -120,8 +146,12 @@ func (f *filter) isAllowed(input map[string]interface{}) (Result, error) {
 		return opaResponse.Result, nil
 	}
 
+	query := f.config.preparedQuery()
+	if query == nil {
+		return Result{Allow: false}, errors.New("policy is not loaded")
+	}
 	ctx := context.TODO()
-	results, err := f.config.query.Eval(ctx, rego.EvalInput(input["input"]))
+	results, err := query.Eval(ctx, rego.EvalInput(input["input"]))
 	if err != nil {
 		return Result{Allow: false}, err
 	}
@@ -185,9 +215,50 @@ func (f *filter) isAllowed(input map[string]interface{}) (Result, error) {
 	return Result{Allow: result, CustomResponse: nil}, nil
 }
 
+func (f *filter) logDecision(input map[string]interface{}, result Result, err error, latency time.Duration) {
+	b, _ := json.Marshal(input["input"])
+	sum := sha256.Sum256(b)
+	log := &decisionLog{
+		Timestamp: time.Now(),
+		InputHash: hex.EncodeToString(sum[:]),
+		Allow:     result.Allow,
+		LatencyNs: latency.Nanoseconds(),
+	}
+	if consumer := f.callbacks.GetConsumer(); consumer != nil {
+		log.Consumer = consumer.Name()
+	}
+	if err != nil {
+		log.Error = err.Error()
+	}
+	f.config.decisionLogSink.Write(log)
+}
+
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
-	input := f.buildInput(headers)
+	if f.config.WithRequestBody && !endStream {
+		return api.WaitAllData
+	}
+	return f.check(headers, nil)
+}
+
+func (f *filter) DecodeRequest(headers api.RequestHeaderMap, data api.BufferInstance, trailers api.RequestTrailerMap) api.ResultAction {
+	var body []byte
+	if data != nil {
+		body = data.Bytes()
+	}
+	return f.check(headers, body)
+}
+
+func (f *filter) check(headers api.RequestHeaderMap, body []byte) api.ResultAction {
+	if body == nil && f.config.WithRequestBody {
+		// the request without body
+		body = []byte{}
+	}
+	input := f.buildInput(headers, body)
+	start := time.Now()
 	result, err := f.isAllowed(input)
+	if f.config.decisionLogSink != nil {
+		f.logDecision(input, result, err, time.Since(start))
+	}
 	if err != nil {
 		api.LogErrorf("failed to do OPA auth: %v", err)
 		return &api.LocalResponse{Code: 503}
@@ -0,0 +1,226 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+var (
+	ErrNotConnected = errors.New("token server is not connected")
+	ErrTimeout      = errors.New("request token timeout")
+
+	errConnClosed = errors.New("connection to token server is closed")
+
+	dialTimeout       = 3 * time.Second
+	reconnectInterval = 2 * time.Second
+)
+
+// TokenResult is the result of requesting tokens from the token server.
+type TokenResult struct {
+	Status    Status
+	Remaining int32
+	WaitInMs  int32
+}
+
+// Client requests tokens from the token server. It keeps a long connection to the server and
+// reconnects in the background when the connection is broken.
+type Client struct {
+	address   string
+	namespace string
+
+	lock    sync.Mutex
+	conn    net.Conn
+	xid     int32
+	pending map[int32]chan *response
+	stopped bool
+	stopCh  chan struct{}
+}
+
+type clientKey struct {
+	address   string
+	namespace string
+}
+
+var (
+	clientsLock sync.Mutex
+	clients     = map[clientKey]*Client{}
+)
+
+// GetClient returns the client connected to the given token server. The client is shared by all
+// configurations with the same address and namespace, and lives as long as the process.
+func GetClient(address, namespace string) *Client {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+
+	key := clientKey{address: address, namespace: namespace}
+	if c, ok := clients[key]; ok {
+		return c
+	}
+	c := NewClient(address, namespace)
+	clients[key] = c
+	return c
+}
+
+// NewClient creates a client and starts connecting to the token server.
+func NewClient(address, namespace string) *Client {
+	c := &Client{
+		address:   address,
+		namespace: namespace,
+		pending:   map[int32]chan *response{},
+		stopCh:    make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+func (c *Client) run() {
+	for {
+		conn, err := net.DialTimeout("tcp", c.address, dialTimeout)
+		if err != nil {
+			api.LogWarnf("failed to connect to sentinel token server %s: %v", c.address, err)
+		} else {
+			api.LogInfof("connected to sentinel token server %s", c.address)
+			c.serve(conn)
+			api.LogWarnf("disconnected from sentinel token server %s", c.address)
+		}
+
+		select {
+		case <-c.stopCh:
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+func (c *Client) serve(conn net.Conn) {
+	c.lock.Lock()
+	if c.stopped {
+		c.lock.Unlock()
+		conn.Close()
+		return
+	}
+	c.conn = conn
+	c.lock.Unlock()
+
+	// register the namespace
+	_, err := c.send(&request{typ: msgTypePing, namespace: c.namespace})
+	if err == nil {
+		reader := bufio.NewReader(conn)
+		for {
+			var b []byte
+			b, err = readFrame(reader)
+			if err != nil {
+				break
+			}
+			var resp *response
+			resp, err = decodeResponse(b)
+			if err != nil {
+				break
+			}
+
+			c.lock.Lock()
+			ch, ok := c.pending[resp.xid]
+			delete(c.pending, resp.xid)
+			c.lock.Unlock()
+			if ok {
+				ch <- resp
+			}
+		}
+	}
+	api.LogInfof("connection to sentinel token server %s closed: %v", c.address, err)
+
+	c.lock.Lock()
+	c.conn = nil
+	for xid, ch := range c.pending {
+		close(ch)
+		delete(c.pending, xid)
+	}
+	c.lock.Unlock()
+	conn.Close()
+}
+
+func (c *Client) send(req *request) (chan *response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.conn == nil {
+		return nil, ErrNotConnected
+	}
+
+	c.xid++
+	req.xid = c.xid
+	ch := make(chan *response, 1)
+	c.pending[req.xid] = ch
+
+	_ = c.conn.SetWriteDeadline(time.Now().Add(dialTimeout))
+	err := writeFrame(c.conn, req.encode())
+	if err != nil {
+		delete(c.pending, req.xid)
+		// let the reader exit
+		c.conn.Close()
+		return nil, err
+	}
+	return ch, nil
+}
+
+// RequestToken requests the tokens of the given flow rule.
+func (c *Client) RequestToken(flowID int64, count int32, timeout time.Duration) (*TokenResult, error) {
+	req := &request{typ: msgTypeFlow, flowID: flowID, count: count}
+	ch, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case resp, ok := <-ch:
+		if !ok {
+			return nil, errConnClosed
+		}
+		return &TokenResult{
+			Status:    resp.status,
+			Remaining: resp.remaining,
+			WaitInMs:  resp.waitInMs,
+		}, nil
+	case <-timer.C:
+		c.lock.Lock()
+		delete(c.pending, req.xid)
+		c.lock.Unlock()
+		return nil, ErrTimeout
+	}
+}
+
+// Close closes the client. The client can't be used anymore.
+func (c *Client) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.stopped {
+		return
+	}
+	c.stopped = true
+	close(c.stopCh)
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
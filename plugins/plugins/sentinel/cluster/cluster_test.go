@@ -0,0 +1,204 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "mosn.io/htnn/api/plugins/tests/pkg/envoy" // mock log
+)
+
+func TestProtocol(t *testing.T) {
+	req := &request{xid: 1, typ: msgTypeFlow, flowID: 2, count: 3, priority: true}
+	var buf bytes.Buffer
+	require.NoError(t, writeFrame(&buf, req.encode()))
+	assert.Equal(t, []byte{
+		0, 18, // length
+		0, 0, 0, 1, // xid
+		1,                      // type
+		0, 0, 0, 0, 0, 0, 0, 2, // flow id
+		0, 0, 0, 3, // count
+		1, // priority
+	}, buf.Bytes())
+
+	b, err := readFrame(bufio.NewReader(&buf))
+	require.NoError(t, err)
+	decoded, err := decodeRequest(b)
+	require.NoError(t, err)
+	assert.Equal(t, req, decoded)
+
+	req = &request{xid: 2, typ: msgTypePing, namespace: "ns"}
+	decoded, err = decodeRequest(req.encode())
+	require.NoError(t, err)
+	assert.Equal(t, req, decoded)
+
+	resp := &response{xid: 3, typ: msgTypeFlow, status: StatusShouldWait, remaining: 4, waitInMs: 5}
+	decodedResp, err := decodeResponse(resp.encode())
+	require.NoError(t, err)
+	assert.Equal(t, resp, decodedResp)
+
+	resp = &response{xid: 4, typ: msgTypeFlow, status: StatusBadRequest}
+	decodedResp, err = decodeResponse(resp.encode()[:6])
+	require.NoError(t, err)
+	assert.Equal(t, resp, decodedResp)
+
+	_, err = decodeRequest([]byte{0, 0, 0, 1, msgTypeFlow, 0})
+	assert.ErrorIs(t, err, errMalformedFrame)
+	_, err = decodeResponse([]byte{0, 0, 0, 1})
+	assert.ErrorIs(t, err, errMalformedFrame)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	w := newSlidingWindow(1000)
+	remaining, ok := w.tryAcquire(1000, 2, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), remaining)
+	_, ok = w.tryAcquire(1500, 2, 3)
+	assert.False(t, ok)
+	remaining, ok = w.tryAcquire(1500, 1, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), remaining)
+
+	// the first bucket is expired
+	remaining, ok = w.tryAcquire(2000, 2, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), remaining)
+	_, ok = w.tryAcquire(2499, 1, 3)
+	assert.False(t, ok)
+	_, ok = w.tryAcquire(2500, 1, 3)
+	assert.True(t, ok)
+}
+
+func waitConnected(t *testing.T, c *Client) {
+	require.Eventually(t, func() bool {
+		_, err := c.RequestToken(0, 1, time.Second)
+		return err == nil
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestClientServer(t *testing.T) {
+	s, err := NewServer("127.0.0.1:0")
+	require.NoError(t, err)
+	defer s.Close()
+	s.LoadRules([]*Rule{
+		{FlowID: 1, Namespace: "ns", Threshold: 1, IntervalMs: 60000},
+		{FlowID: 2, Namespace: "ns", Threshold: 3, Global: true, IntervalMs: 60000},
+	})
+
+	c1 := NewClient(s.Addr().String(), "ns")
+	defer c1.Close()
+	c2 := NewClient(s.Addr().String(), "ns")
+	defer c2.Close()
+	waitConnected(t, c1)
+	waitConnected(t, c2)
+
+	res, err := c1.RequestToken(3, 1, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusNoRuleExists, res.Status)
+	res, err = c1.RequestToken(1, 0, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusBadRequest, res.Status)
+
+	// the threshold is multiplied by the number of connected clients
+	for _, c := range []*Client{c1, c2} {
+		res, err = c.RequestToken(1, 1, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, StatusOK, res.Status)
+	}
+	res, err = c1.RequestToken(1, 1, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusBlocked, res.Status)
+
+	res, err = c1.RequestToken(2, 2, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, res.Status)
+	assert.Equal(t, int32(1), res.Remaining)
+	res, err = c2.RequestToken(2, 2, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusBlocked, res.Status)
+
+	// the statistics are kept when the rule is updated
+	s.LoadRules([]*Rule{
+		{FlowID: 2, Namespace: "ns", Threshold: 4, Global: true, IntervalMs: 60000},
+	})
+	res, err = c2.RequestToken(2, 2, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, res.Status)
+	assert.Equal(t, int32(0), res.Remaining)
+	// the rule not in the new rules is removed
+	res, err = c1.RequestToken(1, 1, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, StatusNoRuleExists, res.Status)
+
+	require.NoError(t, s.Close())
+	assert.Eventually(t, func() bool {
+		_, err := c1.RequestToken(1, 1, time.Second)
+		return err == ErrNotConnected
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestEmbeddedServer(t *testing.T) {
+	s1, err := StartEmbeddedServer("127.0.0.1:0")
+	require.NoError(t, err)
+	s, err := StartEmbeddedServer("127.0.0.1:0")
+	require.NoError(t, err)
+	assert.Same(t, s1, s)
+
+	// the server listening to the old address is closed
+	s2, err := StartEmbeddedServer("localhost:0")
+	require.NoError(t, err)
+	assert.NotSame(t, s1, s2)
+	_, err = net.Dial("tcp", s1.Addr().String())
+	assert.Error(t, err)
+
+	StopEmbeddedServer()
+	_, err = net.Dial("tcp", s2.Addr().String())
+	assert.Error(t, err)
+}
+
+func TestClientTimeout(t *testing.T) {
+	// a server which never responds
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	c := NewClient(ln.Addr().String(), "ns")
+	defer c.Close()
+
+	require.Eventually(t, func() bool {
+		_, err := c.RequestToken(1, 1, 10*time.Millisecond)
+		return err == ErrTimeout
+	}, 3*time.Second, 10*time.Millisecond)
+	c.lock.Lock()
+	// only the ping is left
+	assert.Len(t, c.pending, 1)
+	c.lock.Unlock()
+}
@@ -0,0 +1,206 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The protocol is compatible with the default transport of Sentinel's cluster flow control,
+// so the client can talk to the Sentinel token server.
+// Each frame is prefixed with a 2 bytes length field. The request is encoded as
+// `xid (int32) | type (int8) | data`, and the response is encoded as
+// `xid (int32) | type (int8) | status (int8) | data`.
+
+const (
+	msgTypePing byte = 0
+	msgTypeFlow byte = 1
+
+	maxFrameLength = 1024
+)
+
+// Status is the status of the token result.
+type Status int8
+
+const (
+	StatusBadRequest      Status = -4
+	StatusTooManyRequest  Status = -2
+	StatusFail            Status = -1
+	StatusOK              Status = 0
+	StatusBlocked         Status = 1
+	StatusShouldWait      Status = 2
+	StatusNoRuleExists    Status = 3
+	StatusNoRefRuleExists Status = 4
+	StatusNotAvailable    Status = 5
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusBadRequest:
+		return "BAD_REQUEST"
+	case StatusTooManyRequest:
+		return "TOO_MANY_REQUEST"
+	case StatusFail:
+		return "FAIL"
+	case StatusOK:
+		return "OK"
+	case StatusBlocked:
+		return "BLOCKED"
+	case StatusShouldWait:
+		return "SHOULD_WAIT"
+	case StatusNoRuleExists:
+		return "NO_RULE_EXISTS"
+	case StatusNoRefRuleExists:
+		return "NO_REF_RULE_EXISTS"
+	case StatusNotAvailable:
+		return "NOT_AVAILABLE"
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", int8(s))
+}
+
+var errMalformedFrame = errors.New("malformed frame")
+
+type request struct {
+	xid int32
+	typ byte
+
+	// for ping
+	namespace string
+
+	// for flow
+	flowID   int64
+	count    int32
+	priority bool
+}
+
+func (r *request) encode() []byte {
+	b := binary.BigEndian.AppendUint32(nil, uint32(r.xid))
+	b = append(b, r.typ)
+	switch r.typ {
+	case msgTypePing:
+		b = binary.BigEndian.AppendUint32(b, uint32(len(r.namespace)))
+		b = append(b, r.namespace...)
+	case msgTypeFlow:
+		b = binary.BigEndian.AppendUint64(b, uint64(r.flowID))
+		b = binary.BigEndian.AppendUint32(b, uint32(r.count))
+		if r.priority {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	}
+	return b
+}
+
+func decodeRequest(b []byte) (*request, error) {
+	if len(b) < 5 {
+		return nil, errMalformedFrame
+	}
+	r := &request{
+		xid: int32(binary.BigEndian.Uint32(b)),
+		typ: b[4],
+	}
+	b = b[5:]
+	switch r.typ {
+	case msgTypePing:
+		if len(b) >= 4 {
+			n := int(binary.BigEndian.Uint32(b))
+			if n > len(b)-4 {
+				return nil, errMalformedFrame
+			}
+			r.namespace = string(b[4 : 4+n])
+		}
+	case msgTypeFlow:
+		if len(b) < 12 {
+			return nil, errMalformedFrame
+		}
+		r.flowID = int64(binary.BigEndian.Uint64(b))
+		r.count = int32(binary.BigEndian.Uint32(b[8:]))
+		r.priority = len(b) > 12 && b[12] != 0
+	}
+	return r, nil
+}
+
+type response struct {
+	xid    int32
+	typ    byte
+	status Status
+
+	// for ping, it's the number of the connected clients in the namespace
+	remaining int32
+	waitInMs  int32
+}
+
+func (r *response) encode() []byte {
+	b := binary.BigEndian.AppendUint32(nil, uint32(r.xid))
+	b = append(b, r.typ, byte(r.status))
+	switch r.typ {
+	case msgTypePing:
+		b = binary.BigEndian.AppendUint32(b, uint32(r.remaining))
+	case msgTypeFlow:
+		b = binary.BigEndian.AppendUint32(b, uint32(r.remaining))
+		b = binary.BigEndian.AppendUint32(b, uint32(r.waitInMs))
+	}
+	return b
+}
+
+func decodeResponse(b []byte) (*response, error) {
+	if len(b) < 6 {
+		return nil, errMalformedFrame
+	}
+	r := &response{
+		xid:    int32(binary.BigEndian.Uint32(b)),
+		typ:    b[4],
+		status: Status(int8(b[5])),
+	}
+	b = b[6:]
+	if len(b) >= 4 {
+		r.remaining = int32(binary.BigEndian.Uint32(b))
+	}
+	if r.typ == msgTypeFlow && len(b) >= 8 {
+		r.waitInMs = int32(binary.BigEndian.Uint32(b[4:]))
+	}
+	return r, nil
+}
+
+func writeFrame(w io.Writer, body []byte) error {
+	if len(body) > maxFrameLength {
+		return fmt.Errorf("frame length %d exceeds the limit %d", len(body), maxFrameLength)
+	}
+	b := binary.BigEndian.AppendUint16(make([]byte, 0, len(body)+2), uint16(len(body)))
+	b = append(b, body...)
+	_, err := w.Write(b)
+	return err
+}
+
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(hdr[:]))
+	if n > maxFrameLength {
+		return nil, errMalformedFrame
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,332 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+)
+
+const (
+	defaultIntervalMs = 1000
+	bucketCount       = 10
+)
+
+// Rule is the cluster flow rule served by the embedded token server.
+type Rule struct {
+	FlowID    int64
+	Namespace string
+	Threshold float64
+	// When Global is false, the threshold is the average threshold of each connected client
+	// in the namespace.
+	Global     bool
+	IntervalMs uint32
+}
+
+type flowRule struct {
+	Rule
+
+	window *slidingWindow
+}
+
+type bucket struct {
+	start int64
+	count int64
+}
+
+// slidingWindow counts the passed tokens in the last interval.
+type slidingWindow struct {
+	lock     sync.Mutex
+	bucketMs int64
+	buckets  []bucket
+}
+
+func newSlidingWindow(intervalMs uint32) *slidingWindow {
+	if intervalMs == 0 {
+		intervalMs = defaultIntervalMs
+	}
+	bucketMs := int64(intervalMs) / bucketCount
+	if bucketMs == 0 {
+		bucketMs = 1
+	}
+	return &slidingWindow{
+		bucketMs: bucketMs,
+		buckets:  make([]bucket, bucketCount),
+	}
+}
+
+// tryAcquire adds the count if the sum doesn't exceed the threshold. It returns the remaining
+// tokens and whether the tokens are acquired.
+func (w *slidingWindow) tryAcquire(nowMs int64, count int64, threshold float64) (int64, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	start := nowMs - nowMs%w.bucketMs
+	intervalMs := w.bucketMs * int64(len(w.buckets))
+	var sum int64
+	for _, b := range w.buckets {
+		if b.start > start-intervalMs {
+			sum += b.count
+		}
+	}
+
+	remaining := int64(threshold) - sum - count
+	if remaining < 0 {
+		return 0, false
+	}
+
+	b := &w.buckets[(start/w.bucketMs)%int64(len(w.buckets))]
+	if b.start != start {
+		b.start = start
+		b.count = 0
+	}
+	b.count += count
+	return remaining, true
+}
+
+// Server is the token server embedded in the gateway. It's compatible with Sentinel's
+// cluster flow control protocol.
+type Server struct {
+	listener net.Listener
+
+	lock       sync.RWMutex
+	rules      map[int64]*flowRule
+	namespaces map[string]int
+	conns      map[net.Conn]struct{}
+	closed     bool
+}
+
+// EmbeddedServerEnv is the environment variable which marks the gateway instance running the
+// embedded token server. Only the instance with this variable set to "true" starts the server,
+// so that the other instances which share the same configuration don't become token servers.
+const EmbeddedServerEnv = "HTNN_SENTINEL_TOKEN_SERVER"
+
+// EmbeddedServerEnabled reports whether this instance should run the embedded token server.
+func EmbeddedServerEnabled() bool {
+	return os.Getenv(EmbeddedServerEnv) == "true"
+}
+
+var (
+	embeddedServerLock    sync.Mutex
+	embeddedServer        *Server
+	embeddedServerAddress string
+)
+
+// StartEmbeddedServer starts the token server listening to the given address. Like the Sentinel
+// rules, there is only one embedded server in the process, which follows the latest configuration.
+// The server listening to another address is closed.
+func StartEmbeddedServer(address string) (*Server, error) {
+	embeddedServerLock.Lock()
+	defer embeddedServerLock.Unlock()
+
+	if embeddedServer != nil {
+		if embeddedServerAddress == address {
+			return embeddedServer, nil
+		}
+		stopEmbeddedServer()
+	}
+	s, err := NewServer(address)
+	if err != nil {
+		return nil, err
+	}
+	embeddedServer = s
+	embeddedServerAddress = address
+	return s, nil
+}
+
+// StopEmbeddedServer closes the embedded token server if it's started.
+func StopEmbeddedServer() {
+	embeddedServerLock.Lock()
+	defer embeddedServerLock.Unlock()
+
+	stopEmbeddedServer()
+}
+
+func stopEmbeddedServer() {
+	if embeddedServer == nil {
+		return
+	}
+	err := embeddedServer.Close()
+	if err != nil {
+		api.LogErrorf("failed to close sentinel token server at %s, err: %v", embeddedServerAddress, err)
+	} else {
+		api.LogInfof("sentinel token server at %s stopped", embeddedServerAddress)
+	}
+	embeddedServer = nil
+	embeddedServerAddress = ""
+}
+
+// NewServer creates a token server and starts serving.
+func NewServer(address string) (*Server, error) {
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		listener:   ln,
+		rules:      map[int64]*flowRule{},
+		namespaces: map[string]int{},
+		conns:      map[net.Conn]struct{}{},
+	}
+	go s.serve()
+	api.LogInfof("sentinel token server started at %s", ln.Addr())
+	return s, nil
+}
+
+// Addr returns the listening address.
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// LoadRules replaces the rules. The statistics of the rule is kept if the interval is not changed.
+func (s *Server) LoadRules(rules []*Rule) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	news := make(map[int64]*flowRule, len(rules))
+	for _, r := range rules {
+		old, ok := s.rules[r.FlowID]
+		if ok && old.IntervalMs == r.IntervalMs {
+			old.Rule = *r
+			news[r.FlowID] = old
+			continue
+		}
+		news[r.FlowID] = &flowRule{
+			Rule:   *r,
+			window: newSlidingWindow(r.IntervalMs),
+		}
+	}
+	s.rules = news
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			api.LogErrorf("sentinel token server failed to accept: %v", err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.lock.Unlock()
+
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	namespace := ""
+	defer func() {
+		s.lock.Lock()
+		delete(s.conns, conn)
+		if namespace != "" {
+			s.namespaces[namespace]--
+		}
+		s.lock.Unlock()
+		conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		b, err := readFrame(reader)
+		if err != nil {
+			return
+		}
+		req, err := decodeRequest(b)
+		if err != nil {
+			api.LogInfof("sentinel token server received malformed request from %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+
+		var resp *response
+		switch req.typ {
+		case msgTypePing:
+			s.lock.Lock()
+			if namespace != "" {
+				s.namespaces[namespace]--
+			}
+			namespace = req.namespace
+			s.namespaces[namespace]++
+			n := s.namespaces[namespace]
+			s.lock.Unlock()
+
+			resp = &response{typ: msgTypePing, status: StatusOK, remaining: int32(n)}
+		case msgTypeFlow:
+			resp = s.acquire(req.flowID, req.count)
+		default:
+			resp = &response{typ: req.typ, status: StatusBadRequest}
+		}
+		resp.xid = req.xid
+
+		if err := writeFrame(conn, resp.encode()); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) acquire(flowID int64, count int32) *response {
+	if count <= 0 {
+		return &response{typ: msgTypeFlow, status: StatusBadRequest}
+	}
+
+	s.lock.RLock()
+	r, ok := s.rules[flowID]
+	var threshold float64
+	if ok {
+		threshold = r.Threshold
+		if !r.Global {
+			threshold *= float64(s.namespaces[r.Namespace])
+		}
+	}
+	s.lock.RUnlock()
+
+	if !ok {
+		return &response{typ: msgTypeFlow, status: StatusNoRuleExists}
+	}
+
+	remaining, passed := r.window.tryAcquire(time.Now().UnixMilli(), int64(count), threshold)
+	if !passed {
+		return &response{typ: msgTypeFlow, status: StatusBlocked}
+	}
+	return &response{typ: msgTypeFlow, status: StatusOK, remaining: int32(remaining)}
+}
+
+// Close stops the server and closes all the connections.
+func (s *Server) Close() error {
+	s.lock.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.lock.Unlock()
+	return s.listener.Close()
+}
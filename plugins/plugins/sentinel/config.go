@@ -15,12 +15,15 @@
 package sentinel
 
 import (
+	"time"
+
 	sentinelApi "github.com/alibaba/sentinel-golang/api"
 	sentinelConf "github.com/alibaba/sentinel-golang/core/config"
 	"github.com/alibaba/sentinel-golang/logging"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/plugins/plugins/sentinel/cluster"
 	"mosn.io/htnn/plugins/plugins/sentinel/rules"
 	"mosn.io/htnn/types/plugins/sentinel"
 )
@@ -47,6 +50,9 @@ type config struct {
 	params      sentinelApi.EntryOption
 	attachments []*sentinel.Source
 	m           *res2RuleMap
+
+	clusterClient  *cluster.Client
+	requestTimeout time.Duration
 }
 
 type res2RuleMap struct {
@@ -77,6 +83,55 @@ func (conf *config) Init(cb api.ConfigCallbackHandler) error {
 		return err
 	}
 
+	if err := initCluster(conf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func initCluster(conf *config) error {
+	c := conf.GetCluster()
+	if c == nil {
+		cluster.StopEmbeddedServer()
+		return nil
+	}
+
+	namespace := c.GetNamespace()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	if es := c.GetEmbeddedServer(); es != nil && cluster.EmbeddedServerEnabled() {
+		server, err := cluster.StartEmbeddedServer(es.GetListenAddress())
+		if err != nil {
+			return err
+		}
+
+		var rs []*cluster.Rule
+		for _, r := range conf.GetFlow().GetRules() {
+			cc := r.GetCluster()
+			if cc == nil {
+				continue
+			}
+			rs = append(rs, &cluster.Rule{
+				FlowID:     cc.GetFlowId(),
+				Namespace:  namespace,
+				Threshold:  r.GetThreshold(),
+				Global:     cc.GetThresholdType() == sentinel.ClusterFlowConfig_GLOBAL,
+				IntervalMs: r.GetStatIntervalInMs(),
+			})
+		}
+		server.LoadRules(rs)
+	} else {
+		cluster.StopEmbeddedServer()
+	}
+
+	conf.requestTimeout = 20 * time.Millisecond
+	if c.GetRequestTimeout() != nil {
+		conf.requestTimeout = c.GetRequestTimeout().AsDuration()
+	}
+	conf.clusterClient = cluster.GetClient(c.GetServerAddress(), namespace)
 	return nil
 }
 
@@ -15,10 +15,14 @@
 package sentinel
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"mosn.io/htnn/plugins/plugins/sentinel/cluster"
 )
 
 func TestConfig(t *testing.T) {
@@ -52,6 +56,42 @@ func TestConfig(t *testing.T) {
 			input: `{"resource": {"from": "HEADER", "key": "test"}, "flow": {"rules": [{"resource": "f2", "relationStrategy": "ASSOCIATED_RESOURCE", "refResource": "f1"}]}}`,
 			err:   "",
 		},
+		{
+			name:  "cluster err: cluster is required",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "flow": {"rules": [{"resource": "flow", "threshold": 10, "cluster": {"flowId": 1}}]}}`,
+			err:   "'cluster' must be configured when the rule is a cluster rule",
+		},
+		{
+			name:  "cluster err: flow id must be greater than 0",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {"serverAddress": "127.0.0.1:18730"}, "flow": {"rules": [{"resource": "flow", "threshold": 10, "cluster": {}}]}}`,
+			err:   "invalid ClusterFlowConfig.FlowId: value must be greater than 0",
+		},
+		{
+			name: "cluster err: duplicate flow id",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {"serverAddress": "127.0.0.1:18730"}, "flow": {"rules": [
+				{"resource": "f1", "threshold": 10, "cluster": {"flowId": 1}},
+				{"resource": "f2", "threshold": 10, "cluster": {"flowId": 1}}
+			]}}`,
+			err: "duplicate cluster 'flowId' 1 with flow resource f1",
+		},
+		{
+			name: "cluster err: associated resource",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {"serverAddress": "127.0.0.1:18730"}, "flow": {"rules": [
+				{"resource": "f2", "relationStrategy": "ASSOCIATED_RESOURCE", "refResource": "f1", "cluster": {"flowId": 1}}
+			]}}`,
+			err: "cluster rule doesn't support 'relationStrategy' == ASSOCIATED_RESOURCE",
+		},
+		{
+			name:  "cluster err: server address is required",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {}, "flow": {"rules": [{"resource": "flow", "threshold": 10}]}}`,
+			err:   "invalid Cluster.ServerAddress: value length must be at least 1 runes",
+		},
+		{
+			name: "cluster ok",
+			input: `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {"serverAddress": "127.0.0.1:18730", "embeddedServer": {"listenAddress": "127.0.0.1:0"}},
+				"flow": {"rules": [{"resource": "flow", "threshold": 10, "cluster": {"flowId": 1, "thresholdType": "GLOBAL"}}]}}`,
+			err: "",
+		},
 		{
 			name:  "hot spot err: one of params, attachments is required",
 			input: `{"resource": {"from": "HEADER", "key": "test"}, "hotSpot": {}}`,
@@ -97,3 +137,31 @@ func TestConfig(t *testing.T) {
 	}
 
 }
+
+func TestInitEmbeddedServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	input := `{"resource": {"from": "HEADER", "key": "test"}, "cluster": {"serverAddress": "` + address + `", "embeddedServer": {"listenAddress": "` + address + `"}},
+		"flow": {"rules": [{"resource": "flow", "threshold": 10, "cluster": {"flowId": 1}}]}}`
+	initConfig := func() {
+		conf := &config{}
+		require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+		require.NoError(t, conf.Validate())
+		require.NoError(t, conf.Init(nil))
+	}
+	defer cluster.StopEmbeddedServer()
+
+	// the instance without the mark is not a token server
+	initConfig()
+	_, err = net.Dial("tcp", address)
+	assert.Error(t, err)
+
+	t.Setenv(cluster.EmbeddedServerEnv, "true")
+	initConfig()
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	conn.Close()
+}
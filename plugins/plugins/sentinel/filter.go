@@ -18,11 +18,14 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	sentinel "github.com/alibaba/sentinel-golang/api"
 	"github.com/alibaba/sentinel-golang/core/base"
 
 	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/plugins/plugins/sentinel/cluster"
+	"mosn.io/htnn/plugins/plugins/sentinel/rules"
 	types "mosn.io/htnn/types/plugins/sentinel"
 )
 
@@ -39,6 +42,8 @@ type filter struct {
 	callbacks api.FilterCallbackHandler
 	config    *config
 	entry     atomic.Pointer[base.SentinelEntry]
+	// the entry of the cluster rule when falling back to local flow control
+	fallbackEntry atomic.Pointer[base.SentinelEntry]
 }
 
 func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api.ResultAction {
@@ -62,46 +67,35 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 		attachments[a.GetKey()] = v
 	}
 
+	if r, exist := f.config.m.f[res]; exist && r.GetCluster() != nil {
+		if resp := f.checkCluster(res, r); resp != nil {
+			return resp
+		}
+	}
+
 	e, b := sentinel.Entry(res, f.config.params, sentinel.WithAttachments(attachments))
 
 	if b != nil {
 		api.LogDebugf("blocked, resource: %s, type: %s, rule: %+v, snapshot: %+v",
 			res, b.BlockType().String(), b.TriggeredRule(), b.TriggeredValue())
 
-		resp := &types.BlockResponse{
-			Message:    "blocked by sentinel traffic control",
-			StatusCode: 429,
-		}
-
+		var resp *types.BlockResponse
 		switch b.BlockType() {
 		case base.BlockTypeFlow:
-			if r, exist := f.config.m.f[res]; exist && r.GetBlockResponse() != nil {
+			if r, exist := f.config.m.f[res]; exist {
 				resp = r.GetBlockResponse()
 			}
 		case base.BlockTypeHotSpotParamFlow:
-			if r, exist := f.config.m.hs[res]; exist && r.GetBlockResponse() != nil {
+			if r, exist := f.config.m.hs[res]; exist {
 				resp = r.GetBlockResponse()
 			}
 		case base.BlockTypeCircuitBreaking:
-			if r, exist := f.config.m.cb[res]; exist && r.GetBlockResponse() != nil {
+			if r, exist := f.config.m.cb[res]; exist {
 				resp = r.GetBlockResponse()
 			}
 		}
 
-		header := make(map[string][]string)
-		for k, v := range resp.Headers {
-			vals := strings.Split(v, ",")
-			for i := range vals {
-				vals[i] = strings.TrimSpace(vals[i])
-			}
-			header[k] = vals
-		}
-
-		return &api.LocalResponse{
-			Code:   int(resp.StatusCode),
-			Msg:    resp.Message,
-			Header: header,
-		}
+		return blockResponse(resp)
 	}
 
 	f.entry.Store(e)
@@ -110,8 +104,66 @@ func (f *filter) DecodeHeaders(headers api.RequestHeaderMap, endStream bool) api
 	return api.Continue
 }
 
+func blockResponse(resp *types.BlockResponse) *api.LocalResponse {
+	if resp == nil {
+		resp = &types.BlockResponse{
+			Message:    "blocked by sentinel traffic control",
+			StatusCode: 429,
+		}
+	}
+
+	header := make(map[string][]string)
+	for k, v := range resp.Headers {
+		vals := strings.Split(v, ",")
+		for i := range vals {
+			vals[i] = strings.TrimSpace(vals[i])
+		}
+		header[k] = vals
+	}
+
+	return &api.LocalResponse{
+		Code:   int(resp.StatusCode),
+		Msg:    resp.Message,
+		Header: header,
+	}
+}
+
+// checkCluster requests the token from the token server, and falls back to local flow control
+// when the token server is unavailable. It returns the block response if the request is blocked.
+func (f *filter) checkCluster(res string, r *types.FlowRule) *api.LocalResponse {
+	result, err := f.config.clusterClient.RequestToken(r.GetCluster().GetFlowId(), 1, f.config.requestTimeout)
+	if err == nil {
+		switch result.Status {
+		case cluster.StatusOK:
+			api.LogDebugf("passed by token server, resource: %s, remaining: %d", res, result.Remaining)
+			return nil
+		case cluster.StatusBlocked:
+			api.LogDebugf("blocked by token server, resource: %s", res)
+			return blockResponse(r.GetBlockResponse())
+		case cluster.StatusShouldWait:
+			time.Sleep(time.Duration(result.WaitInMs) * time.Millisecond)
+			return nil
+		}
+		api.LogInfof("failed to request token, resource: %s, status: %s, fallback to local flow control", res, result.Status)
+	} else {
+		api.LogInfof("failed to request token, resource: %s, err: %v, fallback to local flow control", res, err)
+	}
+
+	e, b := sentinel.Entry(rules.FallbackResource(res))
+	if b != nil {
+		api.LogDebugf("blocked by local flow control, resource: %s, snapshot: %+v", res, b.TriggeredValue())
+		return blockResponse(r.GetBlockResponse())
+	}
+	f.fallbackEntry.Store(e)
+	return nil
+}
+
 func (f *filter) OnLog(reqHeaders api.RequestHeaderMap, reqTrailers api.RequestTrailerMap,
 	respHeaders api.ResponseHeaderMap, respTrailers api.ResponseTrailerMap) {
+	if e := f.fallbackEntry.Load(); e != nil {
+		e.Exit()
+	}
+
 	e := f.entry.Load()
 	if e == nil {
 		return
@@ -17,10 +17,15 @@ package sentinel
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
+	"mosn.io/htnn/plugins/plugins/sentinel/cluster"
 	"mosn.io/htnn/types/plugins/sentinel"
 )
 
@@ -81,3 +86,57 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterCluster(t *testing.T) {
+	server, err := cluster.NewServer("127.0.0.1:0")
+	require.NoError(t, err)
+	defer server.Close()
+	server.LoadRules([]*cluster.Rule{
+		{FlowID: 1, Threshold: 2, Global: true, IntervalMs: 60000},
+	})
+
+	newFilter := func(t *testing.T, address string) *filter {
+		conf := &config{}
+		input := `{
+			"resource": {"from": "HEADER", "key": "X-Sentinel"},
+			"cluster": {"serverAddress": "` + address + `", "namespace": "` + t.Name() + `", "requestTimeout": "1s"},
+			"flow": {"rules": [{"resource": "cluster", "threshold": 1, "statIntervalInMs": 60000, "cluster": {"flowId": 1},
+				"blockResponse": {"statusCode": 503}}]}
+		}`
+		require.NoError(t, protojson.Unmarshal([]byte(input), conf))
+		require.NoError(t, conf.Validate())
+		require.NoError(t, conf.Init(nil))
+		return factory(conf, envoy.NewFilterCallbackHandler()).(*filter)
+	}
+	decode := func(f *filter) int {
+		h := http.Header{}
+		h.Set("X-Sentinel", "cluster")
+		h.Set(":path", "/")
+		res := f.DecodeHeaders(envoy.NewRequestHeaderMap(h), true)
+		f.OnLog(nil, nil, envoy.NewResponseHeaderMap(http.Header{":status": []string{"200"}}), nil)
+		if lr, ok := res.(*api.LocalResponse); ok {
+			return lr.Code
+		}
+		return 200
+	}
+
+	t.Run("token server", func(t *testing.T) {
+		f := newFilter(t, server.Addr().String())
+		// wait for the connection
+		require.Eventually(t, func() bool {
+			res, err := f.config.clusterClient.RequestToken(100, 1, time.Second)
+			return err == nil && res.Status == cluster.StatusNoRuleExists
+		}, 3*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 200, decode(f))
+		assert.Equal(t, 200, decode(f))
+		assert.Equal(t, 503, decode(f))
+	})
+
+	t.Run("fallback to local", func(t *testing.T) {
+		ln := server.Addr().String()
+		require.NoError(t, server.Close())
+		f := newFilter(t, ln)
+		assert.Equal(t, 200, decode(f))
+		assert.Equal(t, 503, decode(f))
+	})
+}
@@ -22,6 +22,12 @@ import (
 	types "mosn.io/htnn/types/plugins/sentinel"
 )
 
+// FallbackResource returns the resource used by the cluster rule when falling back to local
+// flow control.
+func FallbackResource(res string) string {
+	return "htnn-cluster-fallback:" + res
+}
+
 func LoadFlowRules(f *types.Flow, m map[string]*types.FlowRule) error {
 	if f == nil {
 		return nil
@@ -44,9 +50,15 @@ func LoadFlowRules(f *types.Flow, m map[string]*types.FlowRule) error {
 		}
 		m[res] = r
 
+		if r.GetCluster() != nil {
+			// The cluster rule is only applied locally when the token server is unavailable.
+			// Rename the resource so it won't take effect in the normal path.
+			res = FallbackResource(res)
+		}
+
 		news = append(news, &flow.Rule{
 			ID:                     r.GetId(),
-			Resource:               r.GetResource(),
+			Resource:               res,
 			TokenCalculateStrategy: flow.TokenCalculateStrategy(r.GetTokenCalculateStrategy()),
 			ControlBehavior:        flow.ControlBehavior(r.GetControlBehavior()),
 			Threshold:              r.GetThreshold(),
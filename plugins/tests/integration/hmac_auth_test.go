@@ -15,8 +15,13 @@
 package integration
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -85,6 +90,80 @@ func TestHmacAuth(t *testing.T) {
 				assert.Equal(t, 401, resp.StatusCode)
 			},
 		},
+		{
+			name: "replay protection",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "hmacAuth",
+					Config: map[string]interface{}{
+						"clockSkew":   "300s",
+						"nonceHeader": "x-nonce",
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				send := func(date time.Time, nonce string) *http.Response {
+					d := date.UTC().Format(http.TimeFormat)
+					h := hmac.New(sha256.New, []byte("sk"))
+					h.Write([]byte("GET\n/echo\n\nak\n" + d + "\n" + nonce + "\nx-custom-a:test\n"))
+					hdr := http.Header{}
+					hdr.Set("x-hmac-signature", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+					hdr.Set("x-hmac-access-key", "ak")
+					hdr.Set("date", d)
+					hdr.Set("x-nonce", nonce)
+					hdr.Set("x-custom-a", "test")
+					resp, _ := dp.Get("/echo", hdr)
+					return resp
+				}
+
+				now := time.Now()
+				resp := send(now, "nonce1")
+				assert.Equal(t, 200, resp.StatusCode)
+				resp = send(now, "nonce1")
+				assert.Equal(t, 401, resp.StatusCode)
+				resp = send(now, "nonce2")
+				assert.Equal(t, 200, resp.StatusCode)
+				resp = send(now.Add(-10*time.Minute), "nonce3")
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
+		{
+			name: "body digest",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "hmacAuth",
+					Config: map[string]interface{}{
+						"bodyDigest": true,
+					},
+				},
+				{
+					Name: "consumerRestriction",
+					Config: map[string]interface{}{
+						"deny_if_no_consumer": true,
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				send := func(signedBody, body string) *http.Response {
+					sum := sha256.Sum256([]byte(signedBody))
+					digest := "sha-256=:" + base64.StdEncoding.EncodeToString(sum[:]) + ":"
+					h := hmac.New(sha256.New, []byte("sk"))
+					h.Write([]byte("POST\n/echo\n\nak\n\nx-custom-a:test\ncontent-digest:" + digest + "\n"))
+					hdr := http.Header{}
+					hdr.Set("x-hmac-signature", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+					hdr.Set("x-hmac-access-key", "ak")
+					hdr.Set("x-custom-a", "test")
+					hdr.Set("content-digest", digest)
+					resp, _ := dp.Post("/echo", hdr, strings.NewReader(body))
+					return resp
+				}
+
+				resp := send("hello", "hello")
+				assert.Equal(t, 200, resp.StatusCode)
+				resp = send("hello", "hell0")
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
 		{
 			name: "bypass if no credential",
 			config: controlplane.NewPluginConfig([]*model.FilterConfig{
@@ -0,0 +1,106 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mosn.io/htnn/api/pkg/filtermanager"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+	"mosn.io/htnn/api/plugins/tests/integration/controlplane"
+	"mosn.io/htnn/api/plugins/tests/integration/dataplane"
+)
+
+func TestJwtAuth(t *testing.T) {
+	dp, err := dataplane.StartDataPlane(t, &dataplane.Option{
+		Bootstrap: dataplane.Bootstrap().AddConsumer("rick", map[string]interface{}{
+			"auth": map[string]interface{}{
+				"jwtAuth": `{"claimValue":"rick"}`,
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to start data plane: %v", err)
+		return
+	}
+	defer dp.Stop()
+
+	secret := []byte("a-secret-which-is-long-enough-for-hs256")
+	jwks, _ := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: secret, KeyID: "hs"}}})
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: secret},
+		(&jose.SignerOptions{}).WithHeader(jose.HeaderKey("kid"), "hs"))
+	require.NoError(t, err)
+	sign := func(sub string, exp time.Time) string {
+		token, err := jwt.Signed(signer).Claims(jwt.Claims{
+			Subject: sub,
+			Issuer:  "htnn",
+			Expiry:  jwt.NewNumericDate(exp),
+		}).Serialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name   string
+		config *filtermanager.FilterManagerConfig
+		run    func(t *testing.T)
+	}{
+		{
+			name: "token in the header",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "jwtAuth",
+					Config: map[string]interface{}{
+						"localJwks": string(jwks),
+						"issuer":    "htnn",
+					},
+				},
+				{
+					Name: "consumerRestriction",
+					Config: map[string]interface{}{
+						"deny_if_no_consumer": true,
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				now := time.Now()
+				resp, _ := dp.Get("/echo", http.Header{"Authorization": []string{"Bearer " + sign("rick", now.Add(time.Hour))}})
+				assert.Equal(t, 200, resp.StatusCode)
+				assert.Equal(t, 0, len(resp.Header.Values("Echo-Authorization")))
+				resp, _ = dp.Get("/echo", http.Header{"Authorization": []string{"Bearer " + sign("morty", now.Add(time.Hour))}})
+				assert.Equal(t, 401, resp.StatusCode)
+				resp, _ = dp.Get("/echo", http.Header{"Authorization": []string{"Bearer " + sign("rick", now.Add(-time.Hour))}})
+				assert.Equal(t, 401, resp.StatusCode)
+				resp, _ = dp.Get("/echo", nil)
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlane.UseGoPluginConfig(t, tt.config, dp)
+			tt.run(t)
+		})
+	}
+}
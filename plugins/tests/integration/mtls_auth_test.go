@@ -0,0 +1,98 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/htnn/api/pkg/filtermanager"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+	"mosn.io/htnn/api/plugins/tests/integration/controlplane"
+	"mosn.io/htnn/api/plugins/tests/integration/dataplane"
+)
+
+func TestMtlsAuth(t *testing.T) {
+	dp, err := dataplane.StartDataPlane(t, &dataplane.Option{
+		Bootstrap: dataplane.Bootstrap().AddConsumer("client", map[string]interface{}{
+			"auth": map[string]interface{}{
+				"mtlsAuth": `{"san":"client.example.com"}`,
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to start data plane: %v", err)
+		return
+	}
+	defer dp.Stop()
+
+	tests := []struct {
+		name   string
+		config *filtermanager.FilterManagerConfig
+		run    func(t *testing.T)
+	}{
+		{
+			// The listener of the data plane doesn't use TLS
+			name: "no client certificate",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "mtlsAuth",
+					Config: map[string]interface{}{
+						"forwardHeaders": []interface{}{
+							map[string]interface{}{
+								"field": "SUBJECT",
+								"name":  "x-client-subject",
+							},
+						},
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				hdr := http.Header{"X-Client-Subject": []string{"CN=spoofed"}}
+				resp, _ := dp.Get("/echo", hdr)
+				assert.Equal(t, 200, resp.StatusCode)
+				assert.Equal(t, 0, len(resp.Header.Values("Echo-X-Client-Subject")))
+			},
+		},
+		{
+			name: "no consumer",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name:   "mtlsAuth",
+					Config: map[string]interface{}{},
+				},
+				{
+					Name: "consumerRestriction",
+					Config: map[string]interface{}{
+						"deny_if_no_consumer": true,
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				resp, _ := dp.Get("/echo", nil)
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlane.UseGoPluginConfig(t, tt.config, dp)
+			tt.run(t)
+		})
+	}
+}
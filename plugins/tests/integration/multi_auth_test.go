@@ -0,0 +1,118 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mosn.io/htnn/api/pkg/filtermanager"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+	"mosn.io/htnn/api/plugins/tests/integration/controlplane"
+	"mosn.io/htnn/api/plugins/tests/integration/dataplane"
+)
+
+func TestMultiAuth(t *testing.T) {
+	dp, err := dataplane.StartDataPlane(t, &dataplane.Option{
+		Bootstrap: dataplane.Bootstrap().
+			AddConsumer("rick", map[string]interface{}{
+				"auth": map[string]interface{}{
+					"keyAuth": `{"key":"rick"}`,
+				},
+			}).
+			AddConsumer("morty", map[string]interface{}{
+				"auth": map[string]interface{}{
+					// the hash of "password"
+					"basicAuth": `{"username":"morty","passwordHash":"$2a$10$INPY9s5UXSsQkQ9lwO2mWekbofTIOzoD0Rm15swqn0AFktA6RqEUO"}`,
+				},
+			}),
+	})
+	if err != nil {
+		t.Fatalf("failed to start data plane: %v", err)
+		return
+	}
+	defer dp.Stop()
+
+	basic := func(username, password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+
+	tests := []struct {
+		name   string
+		config *filtermanager.FilterManagerConfig
+		run    func(t *testing.T)
+	}{
+		{
+			name: "sanity",
+			config: controlplane.NewPluginConfig([]*model.FilterConfig{
+				{
+					Name: "multiAuth",
+					Config: map[string]interface{}{
+						"plugins": []interface{}{
+							map[string]interface{}{
+								"name": "keyAuth",
+								"config": map[string]interface{}{
+									"keys": []interface{}{
+										map[string]interface{}{
+											"name": "x-api-key",
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "basicAuth",
+								"config": map[string]interface{}{
+									"realm": "internal",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "consumerRestriction",
+					Config: map[string]interface{}{
+						"deny_if_no_consumer": true,
+					},
+				},
+			}),
+			run: func(t *testing.T) {
+				resp, _ := dp.Get("/echo", http.Header{"X-Api-Key": []string{"rick"}})
+				assert.Equal(t, 200, resp.StatusCode)
+				resp, _ = dp.Get("/echo", http.Header{"Authorization": []string{basic("morty", "password")}})
+				assert.Equal(t, 200, resp.StatusCode)
+				resp, _ = dp.Get("/echo", http.Header{
+					"X-Api-Key":     []string{"unknown"},
+					"Authorization": []string{basic("morty", "password")},
+				})
+				assert.Equal(t, 200, resp.StatusCode)
+				resp, _ = dp.Get("/echo", http.Header{"Authorization": []string{basic("morty", "wrong")}})
+				assert.Equal(t, 401, resp.StatusCode)
+				assert.Equal(t, `Basic realm="internal"`, resp.Header.Get("Www-Authenticate"))
+				resp, _ = dp.Get("/echo", nil)
+				assert.Equal(t, 401, resp.StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controlPlane.UseGoPluginConfig(t, tt.config, dp)
+			tt.run(t)
+		})
+	}
+}
@@ -21,4 +21,14 @@ import runtime "k8s.io/apimachinery/pkg/runtime"
 // Plugin defines the plugin configuration
 type Plugin struct {
 	Config runtime.RawExtension `json:"config"`
+	// Match is a CEL expression which returns bool, like `request.path().startsWith("/admin")`.
+	// The plugin is only run when the expression is evaluated to true.
+	//
+	// +optional
+	Match string `json:"match,omitempty"`
+	// Timeout limits the time spent by the plugin in each phase, like `200ms`.
+	// See `pluginTimeout` in the filtermanager configuration for what happens after the timeout.
+	//
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	istiov1a3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +27,7 @@ import (
 
 	"mosn.io/htnn/api/pkg/dynamicconfig"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
 	"mosn.io/htnn/types/pkg/proto"
 	"mosn.io/htnn/types/pkg/registry"
 )
@@ -161,6 +163,30 @@ func validateFilter(name string, filter Plugin, strict bool, targetGateway bool)
 	if err := conf.Validate(); err != nil {
 		return fmt.Errorf("invalid config for filter %s: %w", name, err)
 	}
+
+	if filter.Match != "" {
+		switch p.Order().Position {
+		case plugins.OrderPositionOuter, plugins.OrderPositionInner:
+			return fmt.Errorf("match is not supported by native plugin %s", name)
+		}
+		if _, err := expr.CompilePluginMatcher(filter.Match); err != nil {
+			return fmt.Errorf("invalid match for filter %s: %w", name, err)
+		}
+	}
+
+	if filter.Timeout != "" {
+		switch p.Order().Position {
+		case plugins.OrderPositionOuter, plugins.OrderPositionInner:
+			return fmt.Errorf("timeout is not supported by native plugin %s", name)
+		}
+		d, err := time.ParseDuration(filter.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout for filter %s: %w", name, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("invalid timeout for filter %s: should be positive", name)
+		}
+	}
 	return nil
 }
 
@@ -325,6 +351,13 @@ func ValidateConsumer(c *Consumer) error {
 	}
 
 	for name, filter := range c.Spec.Filters {
+		if filter.Match != "" {
+			return errors.New("match is not supported in consumer: " + name)
+		}
+		if filter.Timeout != "" {
+			return errors.New("timeout is not supported in consumer: " + name)
+		}
+
 		p := plugins.LoadPluginType(name)
 		if p == nil {
 			return errors.New("unknown http filter: " + name)
@@ -100,6 +100,136 @@ func TestValidateFilterPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ok, match",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Match: `request.path().startsWith("/admin")`,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid match",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Match: `request.path()`,
+						},
+					},
+				},
+			},
+			err: "invalid match for filter animal",
+		},
+		{
+			name: "ok, timeout",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Timeout: "200ms",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid timeout",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Timeout: "200",
+						},
+					},
+				},
+			},
+			err: "invalid timeout for filter animal",
+		},
+		{
+			name: "negative timeout",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Timeout: "-1s",
+						},
+					},
+				},
+			},
+			err: "invalid timeout for filter animal: should be positive",
+		},
+		{
+			name: "timeout with native plugin",
+			policy: &FilterPolicy{
+				Spec: FilterPolicySpec{
+					TargetRef: &gwapiv1a2.PolicyTargetReferenceWithSectionName{
+						PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+							Group: "networking.istio.io",
+							Kind:  "VirtualService",
+						},
+					},
+					Filters: map[string]Plugin{
+						"localRatelimit": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"statPrefix":"local"}`),
+							},
+							Timeout: "1s",
+						},
+					},
+				},
+			},
+			err: "timeout is not supported by native plugin localRatelimit",
+		},
 		{
 			name: "unknown fields, VirtualService",
 			policy: &FilterPolicy{
@@ -805,6 +935,52 @@ func TestValidateConsumer(t *testing.T) {
 			},
 			err: "invalid config for filter opa",
 		},
+		{
+			name: "match in filter",
+			consumer: &Consumer{
+				Spec: ConsumerSpec{
+					Auth: map[string]ConsumerPlugin{
+						"keyAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"key":"cat"}`),
+							},
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Match: `request.path() == "/"`,
+						},
+					},
+				},
+			},
+			err: "match is not supported in consumer: animal",
+		},
+		{
+			name: "timeout in filter",
+			consumer: &Consumer{
+				Spec: ConsumerSpec{
+					Auth: map[string]ConsumerPlugin{
+						"keyAuth": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"key":"cat"}`),
+							},
+						},
+					},
+					Filters: map[string]Plugin{
+						"animal": {
+							Config: runtime.RawExtension{
+								Raw: []byte(`{"pet":"cat"}`),
+							},
+							Timeout: "1s",
+						},
+					},
+				},
+			},
+			err: "timeout is not supported in consumer: animal",
+		},
 		{
 			name: "invalid filter",
 			consumer: &Consumer{
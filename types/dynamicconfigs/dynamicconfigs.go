@@ -15,5 +15,6 @@
 package dynamicconfigs
 
 import (
+	_ "mosn.io/htnn/types/dynamicconfigs/casbinpolicy"
 	_ "mosn.io/htnn/types/dynamicconfigs/demo"
 )
@@ -24,6 +24,7 @@ import (
 	"github.com/google/cel-go/common/types"
 	"github.com/stretchr/testify/require"
 
+	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/plugins/tests/pkg/envoy"
 )
 
@@ -199,6 +200,13 @@ func TestCelWithRequest(t *testing.T) {
 				require.Equal(t, "property.request.id", res)
 			},
 		},
+		{
+			name: "consumer not found",
+			code: `request.consumer()`,
+			expect: func(t *testing.T, res any) {
+				require.Equal(t, "", res)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -257,3 +265,29 @@ func TestCelWithSource(t *testing.T) {
 		})
 	}
 }
+
+type fakeConsumer struct {
+	name string
+}
+
+func (c *fakeConsumer) Name() string {
+	return c.name
+}
+
+func (c *fakeConsumer) PluginConfig(_ string) api.PluginConsumerConfig {
+	return nil
+}
+
+func TestCelWithConsumer(t *testing.T) {
+	s, err := CompileCel(`request.consumer() == "gold" ? 1 : 5`, cel.IntType)
+	require.NoError(t, err)
+	cb := envoy.NewFilterCallbackHandler()
+	res, err := s.EvalWithRequest(cb, envoy.NewRequestHeaderMap(http.Header{}))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res)
+
+	cb.SetConsumer(&fakeConsumer{name: "gold"})
+	res, err = s.EvalWithRequest(cb, envoy.NewRequestHeaderMap(http.Header{}))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res)
+}
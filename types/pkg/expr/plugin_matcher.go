@@ -0,0 +1,55 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expr
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+
+	"mosn.io/htnn/api/pkg/filtermanager"
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/filtermanager/model"
+)
+
+func init() {
+	filtermanager.RegisterPluginMatcherCompiler(CompilePluginMatcher)
+}
+
+type pluginMatcher struct {
+	script Script
+}
+
+func (m *pluginMatcher) Match(cb api.FilterCallbackHandler, headers api.RequestHeaderMap) (bool, error) {
+	res, err := m.script.EvalWithRequest(cb, headers)
+	if err != nil {
+		return false, err
+	}
+	matched, ok := res.(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected result type: %s", reflect.TypeOf(res))
+	}
+	return matched, nil
+}
+
+// CompilePluginMatcher compiles the `match` expression of the plugin, which should return bool.
+func CompilePluginMatcher(expr string) (model.PluginMatcher, error) {
+	script, err := CompileCel(expr, cel.BoolType)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginMatcher{script: script}, nil
+}
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: types/plugins/api/v1/http_client.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on HTTPCircuitBreaker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HTTPCircuitBreaker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPCircuitBreaker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HTTPCircuitBreakerMultiError, or nil if none found.
+func (m *HTTPCircuitBreaker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPCircuitBreaker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsecutiveFailures
+
+	if d := m.GetOpenDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HTTPCircuitBreakerValidationError{
+				field:  "OpenDuration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HTTPCircuitBreakerValidationError{
+					field:  "OpenDuration",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return HTTPCircuitBreakerMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPCircuitBreakerMultiError is an error wrapping multiple validation errors
+// returned by HTTPCircuitBreaker.ValidateAll() if the designated constraints
+// aren't met.
+type HTTPCircuitBreakerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPCircuitBreakerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPCircuitBreakerMultiError) AllErrors() []error { return m }
+
+// HTTPCircuitBreakerValidationError is the validation error returned by
+// HTTPCircuitBreaker.Validate if the designated constraints aren't met.
+type HTTPCircuitBreakerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPCircuitBreakerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPCircuitBreakerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPCircuitBreakerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPCircuitBreakerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPCircuitBreakerValidationError) ErrorName() string {
+	return "HTTPCircuitBreakerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HTTPCircuitBreakerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPCircuitBreaker.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPCircuitBreakerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPCircuitBreakerValidationError{}
+
+// Validate checks the field values on HTTPClientTLS with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HTTPClientTLS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPClientTLS with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HTTPClientTLSMultiError, or
+// nil if none found.
+func (m *HTTPClientTLS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPClientTLS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaCert
+
+	// no validation rules for ClientCert
+
+	// no validation rules for ClientKey
+
+	// no validation rules for ServerName
+
+	// no validation rules for InsecureSkipVerify
+
+	if len(errors) > 0 {
+		return HTTPClientTLSMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPClientTLSMultiError is an error wrapping multiple validation errors
+// returned by HTTPClientTLS.ValidateAll() if the designated constraints
+// aren't met.
+type HTTPClientTLSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPClientTLSMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPClientTLSMultiError) AllErrors() []error { return m }
+
+// HTTPClientTLSValidationError is the validation error returned by
+// HTTPClientTLS.Validate if the designated constraints aren't met.
+type HTTPClientTLSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPClientTLSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPClientTLSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPClientTLSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPClientTLSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPClientTLSValidationError) ErrorName() string { return "HTTPClientTLSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HTTPClientTLSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPClientTLS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPClientTLSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPClientTLSValidationError{}
+
+// Validate checks the field values on HTTPRetryPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HTTPRetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPRetryPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HTTPRetryPolicyMultiError, or nil if none found.
+func (m *HTTPRetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPRetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMaxRetries() > 10 {
+		err := HTTPRetryPolicyValidationError{
+			field:  "MaxRetries",
+			reason: "value must be less than or equal to 10",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetBaseInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HTTPRetryPolicyValidationError{
+				field:  "BaseInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HTTPRetryPolicyValidationError{
+					field:  "BaseInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetMaxInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HTTPRetryPolicyValidationError{
+				field:  "MaxInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HTTPRetryPolicyValidationError{
+					field:  "MaxInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return HTTPRetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPRetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by HTTPRetryPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type HTTPRetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPRetryPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPRetryPolicyMultiError) AllErrors() []error { return m }
+
+// HTTPRetryPolicyValidationError is the validation error returned by
+// HTTPRetryPolicy.Validate if the designated constraints aren't met.
+type HTTPRetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPRetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPRetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPRetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPRetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPRetryPolicyValidationError) ErrorName() string { return "HTTPRetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HTTPRetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPRetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPRetryPolicyValidationError{}
@@ -0,0 +1,167 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/api/v1/llm.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The protocols of the LLM APIs.
+type LLMProtocol int32
+
+const (
+	// OpenAI Chat Completions API, like `/v1/chat/completions`.
+	LLMProtocol_OPENAI_CHAT_COMPLETIONS LLMProtocol = 0
+	// OpenAI Responses API, like `/v1/responses`.
+	LLMProtocol_OPENAI_RESPONSES LLMProtocol = 1
+	// Anthropic Messages API, like `/v1/messages`.
+	LLMProtocol_ANTHROPIC_MESSAGES LLMProtocol = 2
+	// Gemini generateContent API, like `/v1beta/models/{model}:generateContent` and
+	// `/v1beta/models/{model}:streamGenerateContent?alt=sse`.
+	LLMProtocol_GEMINI_GENERATE_CONTENT LLMProtocol = 3
+	// Ollama API, like `/api/chat` and `/api/generate`.
+	LLMProtocol_OLLAMA LLMProtocol = 4
+)
+
+// Enum value maps for LLMProtocol.
+var (
+	LLMProtocol_name = map[int32]string{
+		0: "OPENAI_CHAT_COMPLETIONS",
+		1: "OPENAI_RESPONSES",
+		2: "ANTHROPIC_MESSAGES",
+		3: "GEMINI_GENERATE_CONTENT",
+		4: "OLLAMA",
+	}
+	LLMProtocol_value = map[string]int32{
+		"OPENAI_CHAT_COMPLETIONS": 0,
+		"OPENAI_RESPONSES":        1,
+		"ANTHROPIC_MESSAGES":      2,
+		"GEMINI_GENERATE_CONTENT": 3,
+		"OLLAMA":                  4,
+	}
+)
+
+func (x LLMProtocol) Enum() *LLMProtocol {
+	p := new(LLMProtocol)
+	*p = x
+	return p
+}
+
+func (x LLMProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LLMProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_api_v1_llm_proto_enumTypes[0].Descriptor()
+}
+
+func (LLMProtocol) Type() protoreflect.EnumType {
+	return &file_types_plugins_api_v1_llm_proto_enumTypes[0]
+}
+
+func (x LLMProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LLMProtocol.Descriptor instead.
+func (LLMProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_api_v1_llm_proto_rawDescGZIP(), []int{0}
+}
+
+var File_types_plugins_api_v1_llm_proto protoreflect.FileDescriptor
+
+var file_types_plugins_api_v1_llm_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2a, 0x81, 0x01, 0x0a, 0x0b, 0x4c, 0x4c, 0x4d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x49,
+	0x5f, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e,
+	0x53, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x49, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4e, 0x54,
+	0x48, 0x52, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x53, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x45, 0x4d, 0x49, 0x4e, 0x49, 0x5f, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x4f, 0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10, 0x04, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x6f,
+	0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_api_v1_llm_proto_rawDescOnce sync.Once
+	file_types_plugins_api_v1_llm_proto_rawDescData = file_types_plugins_api_v1_llm_proto_rawDesc
+)
+
+func file_types_plugins_api_v1_llm_proto_rawDescGZIP() []byte {
+	file_types_plugins_api_v1_llm_proto_rawDescOnce.Do(func() {
+		file_types_plugins_api_v1_llm_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_api_v1_llm_proto_rawDescData)
+	})
+	return file_types_plugins_api_v1_llm_proto_rawDescData
+}
+
+var file_types_plugins_api_v1_llm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_plugins_api_v1_llm_proto_goTypes = []interface{}{
+	(LLMProtocol)(0), // 0: types.plugins.api.v1.LLMProtocol
+}
+var file_types_plugins_api_v1_llm_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_api_v1_llm_proto_init() }
+func file_types_plugins_api_v1_llm_proto_init() {
+	if File_types_plugins_api_v1_llm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_api_v1_llm_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_api_v1_llm_proto_goTypes,
+		DependencyIndexes: file_types_plugins_api_v1_llm_proto_depIdxs,
+		EnumInfos:         file_types_plugins_api_v1_llm_proto_enumTypes,
+	}.Build()
+	File_types_plugins_api_v1_llm_proto = out.File
+	file_types_plugins_api_v1_llm_proto_rawDesc = nil
+	file_types_plugins_api_v1_llm_proto_goTypes = nil
+	file_types_plugins_api_v1_llm_proto_depIdxs = nil
+}
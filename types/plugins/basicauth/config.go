@@ -0,0 +1,161 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicauth
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+)
+
+const (
+	Name = "basicAuth"
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &Config{}
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &CustomConsumerConfig{}
+}
+
+type CustomConsumerConfig struct {
+	ConsumerConfig
+}
+
+func (conf *CustomConsumerConfig) Validate() error {
+	err := conf.ConsumerConfig.Validate()
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(conf.PasswordHash, "$argon2") {
+		_, err = ParseArgon2Hash(conf.PasswordHash)
+		return err
+	}
+	return nil
+}
+
+const (
+	// Argon2Version is the supported argon2 version, which is 0x13
+	Argon2Version = 19
+	// MinArgon2SaltLen is the min length of the salt in bytes, as required by the argon2 spec
+	MinArgon2SaltLen = 8
+	// MinArgon2DigestLen is the min length of the digest in bytes
+	MinArgon2DigestLen = 16
+)
+
+// Argon2Hash is the argon2 hash in PHC format
+type Argon2Hash struct {
+	// Algorithm is either "argon2id" or "argon2i"
+	Algorithm string
+	Memory    uint32
+	Time      uint32
+	Threads   uint8
+	Salt      []byte
+	Digest    []byte
+}
+
+// ParseArgon2Hash parses the argon2 hash in PHC format, like `$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>`.
+func ParseArgon2Hash(hash string) (*Argon2Hash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return nil, errors.New("invalid argon2 hash")
+	}
+
+	h := &Argon2Hash{Algorithm: parts[1]}
+	if h.Algorithm != "argon2id" && h.Algorithm != "argon2i" {
+		return nil, fmt.Errorf("unsupported algorithm %s", h.Algorithm)
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("invalid argon2 version: %w", err)
+	}
+	if version != Argon2Version {
+		return nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+
+	var err error
+	h.Memory, h.Time, h.Threads, err = ParseArgon2Params(parts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	// The PHC format omits the padding, but some tools keep it
+	h.Salt, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[4], "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2 salt: %w", err)
+	}
+	if len(h.Salt) < MinArgon2SaltLen {
+		return nil, fmt.Errorf("invalid argon2 salt: should be at least %d bytes", MinArgon2SaltLen)
+	}
+	h.Digest, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[5], "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2 hash: %w", err)
+	}
+	if len(h.Digest) < MinArgon2DigestLen {
+		return nil, fmt.Errorf("invalid argon2 hash: should be at least %d bytes", MinArgon2DigestLen)
+	}
+	return h, nil
+}
+
+// MaxArgon2Memory is the max memory in KiB used to verify the argon2 hash, which is 1 GiB.
+const MaxArgon2Memory = 1024 * 1024
+
+// ParseArgon2Params parses the argon2 parameters in PHC format, like `m=65536,t=3,p=4`.
+func ParseArgon2Params(params string) (memory uint32, time uint32, threads uint8, err error) {
+	var m, t, p uint64
+	if _, err = fmt.Sscanf(params, "m=%d,t=%d,p=%d", &m, &t, &p); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid argon2 parameters: %w", err)
+	}
+	if m < 1 || m > MaxArgon2Memory {
+		return 0, 0, 0, fmt.Errorf("invalid argon2 memory %d: should be in [1, %d]", m, MaxArgon2Memory)
+	}
+	if t < 1 || t > 1<<32-1 {
+		return 0, 0, 0, fmt.Errorf("invalid argon2 iterations %d: should be positive", t)
+	}
+	if p < 1 || p > 255 {
+		return 0, 0, 0, fmt.Errorf("invalid argon2 parallelism %d: should be in [1, 255]", p)
+	}
+	return uint32(m), uint32(t), uint8(p), nil
+}
+
+func (conf *ConsumerConfig) Index() string {
+	return conf.Username
+}
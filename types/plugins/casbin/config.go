@@ -42,5 +42,76 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	rule := conf.Rule
+	switch rule.PolicySource.(type) {
+	case *Config_Rule_Policy:
+		if rule.GetPolicy() == "" {
+			return Config_RuleValidationError{
+				field:  "Policy",
+				reason: "value length must be at least 1 runes",
+			}
+		}
+	case *Config_Rule_DynamicPolicy:
+		if rule.GetDynamicPolicy() == "" {
+			return Config_RuleValidationError{
+				field:  "DynamicPolicy",
+				reason: "value length must be at least 1 runes",
+			}
+		}
+	default:
+		return Config_RuleValidationError{
+			field:  "PolicySource",
+			reason: "either policy or dynamic_policy is required",
+		}
+	}
+
+	token := conf.Token
+	switch token.Source {
+	case Config_HEADER:
+		if token.Name == "" {
+			return Config_TokenValidationError{
+				field:  "Name",
+				reason: "value is required when the source is HEADER",
+			}
+		}
+	case Config_CONSUMER:
+	default:
+		return Config_TokenValidationError{
+			field:  "Source",
+			reason: "only HEADER and CONSUMER are supported",
+		}
+	}
+
+	domain := conf.Domain
+	if domain != nil {
+		switch domain.Source {
+		case Config_HEADER:
+			if domain.Name == "" {
+				return Config_DomainValidationError{
+					field:  "Name",
+					reason: "value is required when the source is HEADER",
+				}
+			}
+		case Config_HOST:
+		default:
+			return Config_DomainValidationError{
+				field:  "Source",
+				reason: "only HEADER and HOST are supported",
+			}
+		}
+	}
+	return nil
 }
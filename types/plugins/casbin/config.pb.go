@@ -40,15 +40,23 @@ type Config_Source int32
 
 const (
 	Config_HEADER Config_Source = 0
+	// The name of the authenticated consumer.
+	Config_CONSUMER Config_Source = 1
+	// The host of the request, without the port.
+	Config_HOST Config_Source = 2
 )
 
 // Enum value maps for Config_Source.
 var (
 	Config_Source_name = map[int32]string{
 		0: "HEADER",
+		1: "CONSUMER",
+		2: "HOST",
 	}
 	Config_Source_value = map[string]int32{
-		"HEADER": 0,
+		"HEADER":   0,
+		"CONSUMER": 1,
+		"HOST":     2,
 	}
 )
 
@@ -86,6 +94,8 @@ type Config struct {
 
 	Rule  *Config_Rule  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	Token *Config_Token `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// The domain (tenant) used in the RBAC with domains model.
+	Domain *Config_Domain `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -134,13 +144,27 @@ func (x *Config) GetToken() *Config_Token {
 	return nil
 }
 
+func (x *Config) GetDomain() *Config_Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
 type Config_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Model  string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The path of the model file.
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Where to load the policy.
+	//
+	// Types that are assignable to PolicySource:
+	//
+	//	*Config_Rule_Policy
+	//	*Config_Rule_DynamicPolicy
+	PolicySource isConfig_Rule_PolicySource `protobuf_oneof:"policy_source"`
 }
 
 func (x *Config_Rule) Reset() {
@@ -182,20 +206,54 @@ func (x *Config_Rule) GetModel() string {
 	return ""
 }
 
+func (m *Config_Rule) GetPolicySource() isConfig_Rule_PolicySource {
+	if m != nil {
+		return m.PolicySource
+	}
+	return nil
+}
+
 func (x *Config_Rule) GetPolicy() string {
-	if x != nil {
+	if x, ok := x.GetPolicySource().(*Config_Rule_Policy); ok {
 		return x.Policy
 	}
 	return ""
 }
 
+func (x *Config_Rule) GetDynamicPolicy() string {
+	if x, ok := x.GetPolicySource().(*Config_Rule_DynamicPolicy); ok {
+		return x.DynamicPolicy
+	}
+	return ""
+}
+
+type isConfig_Rule_PolicySource interface {
+	isConfig_Rule_PolicySource()
+}
+
+type Config_Rule_Policy struct {
+	// The path of the policy file.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3,oneof"`
+}
+
+type Config_Rule_DynamicPolicy struct {
+	// The name of the policy pushed via the DynamicConfig `casbinPolicy`.
+	DynamicPolicy string `protobuf:"bytes,3,opt,name=dynamic_policy,json=dynamicPolicy,proto3,oneof"`
+}
+
+func (*Config_Rule_Policy) isConfig_Rule_PolicySource() {}
+
+func (*Config_Rule_DynamicPolicy) isConfig_Rule_PolicySource() {}
+
 type Config_Token struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The source of the subject. HEADER and CONSUMER are supported.
 	Source Config_Source `protobuf:"varint,1,opt,name=source,proto3,enum=types.plugins.casbin.Config_Source" json:"source,omitempty"`
-	Name   string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the header. Required when the source is HEADER.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (x *Config_Token) Reset() {
@@ -244,6 +302,63 @@ func (x *Config_Token) GetName() string {
 	return ""
 }
 
+type Config_Domain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source of the domain. HEADER and HOST are supported.
+	Source Config_Source `protobuf:"varint,1,opt,name=source,proto3,enum=types.plugins.casbin.Config_Source" json:"source,omitempty"`
+	// The name of the header. Required when the source is HEADER.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Config_Domain) Reset() {
+	*x = Config_Domain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_casbin_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Domain) ProtoMessage() {}
+
+func (x *Config_Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_casbin_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Domain.ProtoReflect.Descriptor instead.
+func (*Config_Domain) Descriptor() ([]byte, []int) {
+	return file_types_plugins_casbin_config_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Config_Domain) GetSource() Config_Source {
+	if x != nil {
+		return x.Source
+	}
+	return Config_HEADER
+}
+
+func (x *Config_Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_types_plugins_casbin_config_proto protoreflect.FileDescriptor
 
 var file_types_plugins_casbin_config_proto_rawDesc = []byte{
@@ -252,7 +367,7 @@ var file_types_plugins_casbin_config_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
 	0x6e, 0x73, 0x2e, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a,
+	0x74, 0x6f, 0x22, 0xa8, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a,
 	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x73, 0x62,
 	0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x08,
@@ -261,21 +376,35 @@ var file_types_plugins_casbin_config_proto_rawDesc = []byte{
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61,
 	0x73, 0x62, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
 	0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x1a, 0x46, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x61, 0x0a, 0x05, 0x54, 0x6f,
+	0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x1a,
+	0x79, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x27, 0x0a, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x58, 0x0a, 0x05, 0x54, 0x6f,
 	0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67,
 	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a,
-	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41, 0x44, 0x45,
-	0x52, 0x10, 0x00, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68,
-	0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x2f, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x59, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3b,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63,
+	0x61, 0x73, 0x62, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41,
+	0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x42, 0x23, 0x5a,
+	0x21, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x62,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -291,22 +420,25 @@ func file_types_plugins_casbin_config_proto_rawDescGZIP() []byte {
 }
 
 var file_types_plugins_casbin_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_types_plugins_casbin_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_plugins_casbin_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_types_plugins_casbin_config_proto_goTypes = []interface{}{
-	(Config_Source)(0),   // 0: types.plugins.casbin.Config.Source
-	(*Config)(nil),       // 1: types.plugins.casbin.Config
-	(*Config_Rule)(nil),  // 2: types.plugins.casbin.Config.Rule
-	(*Config_Token)(nil), // 3: types.plugins.casbin.Config.Token
+	(Config_Source)(0),    // 0: types.plugins.casbin.Config.Source
+	(*Config)(nil),        // 1: types.plugins.casbin.Config
+	(*Config_Rule)(nil),   // 2: types.plugins.casbin.Config.Rule
+	(*Config_Token)(nil),  // 3: types.plugins.casbin.Config.Token
+	(*Config_Domain)(nil), // 4: types.plugins.casbin.Config.Domain
 }
 var file_types_plugins_casbin_config_proto_depIdxs = []int32{
 	2, // 0: types.plugins.casbin.Config.rule:type_name -> types.plugins.casbin.Config.Rule
 	3, // 1: types.plugins.casbin.Config.token:type_name -> types.plugins.casbin.Config.Token
-	0, // 2: types.plugins.casbin.Config.Token.source:type_name -> types.plugins.casbin.Config.Source
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 2: types.plugins.casbin.Config.domain:type_name -> types.plugins.casbin.Config.Domain
+	0, // 3: types.plugins.casbin.Config.Token.source:type_name -> types.plugins.casbin.Config.Source
+	0, // 4: types.plugins.casbin.Config.Domain.source:type_name -> types.plugins.casbin.Config.Source
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_types_plugins_casbin_config_proto_init() }
@@ -351,6 +483,22 @@ func file_types_plugins_casbin_config_proto_init() {
 				return nil
 			}
 		}
+		file_types_plugins_casbin_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Domain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_casbin_config_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Config_Rule_Policy)(nil),
+		(*Config_Rule_DynamicPolicy)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -358,7 +506,7 @@ func file_types_plugins_casbin_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_plugins_casbin_config_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -136,6 +136,35 @@ func (m *Config) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDomain()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Domain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Domain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDomain()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Domain",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -246,15 +275,33 @@ func (m *Config_Rule) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPolicy()) < 1 {
-		err := Config_RuleValidationError{
-			field:  "Policy",
-			reason: "value length must be at least 1 runes",
+	switch v := m.PolicySource.(type) {
+	case *Config_Rule_Policy:
+		if v == nil {
+			err := Config_RuleValidationError{
+				field:  "PolicySource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+		// no validation rules for Policy
+	case *Config_Rule_DynamicPolicy:
+		if v == nil {
+			err := Config_RuleValidationError{
+				field:  "PolicySource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+		// no validation rules for DynamicPolicy
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
@@ -358,16 +405,7 @@ func (m *Config_Token) validate(all bool) error {
 
 	// no validation rules for Source
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := Config_TokenValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
 		return Config_TokenMultiError(errors)
@@ -445,3 +483,107 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Config_TokenValidationError{}
+
+// Validate checks the field values on Config_Domain with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Config_Domain) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Config_Domain with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Config_DomainMultiError, or
+// nil if none found.
+func (m *Config_Domain) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Config_Domain) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return Config_DomainMultiError(errors)
+	}
+
+	return nil
+}
+
+// Config_DomainMultiError is an error wrapping multiple validation errors
+// returned by Config_Domain.ValidateAll() if the designated constraints
+// aren't met.
+type Config_DomainMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Config_DomainMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Config_DomainMultiError) AllErrors() []error { return m }
+
+// Config_DomainValidationError is the validation error returned by
+// Config_Domain.Validate if the designated constraints aren't met.
+type Config_DomainValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Config_DomainValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Config_DomainValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Config_DomainValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Config_DomainValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Config_DomainValidationError) ErrorName() string { return "Config_DomainValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Config_DomainValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfig_Domain.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Config_DomainValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Config_DomainValidationError{}
@@ -15,8 +15,13 @@
 package extauth
 
 import (
+	"errors"
+
+	"github.com/google/cel-go/cel"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/expr"
 )
 
 const (
@@ -44,5 +49,33 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
+}
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if cache := conf.DecisionCache; cache != nil {
+		if len(cache.KeyHeaders) == 0 && cache.Key == "" {
+			return errors.New("key_headers or key is required in decision_cache")
+		}
+		// The cache key doesn't cover the request body, so the decision can't be reused
+		if conf.GetHttpService().GetWithRequestBody() || conf.GetGrpcService().GetWithRequestBody() {
+			return errors.New("decision_cache can't be used with with_request_body")
+		}
+		if cache.Key != "" {
+			_, err = expr.CompileCel(cache.Key, cel.StringType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
@@ -15,6 +15,8 @@
 package hmacauth
 
 import (
+	"errors"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 )
@@ -42,7 +44,7 @@ func (p *Plugin) Order() plugins.PluginOrder {
 }
 
 func (p *Plugin) Config() api.PluginConfig {
-	return &Config{}
+	return &CustomConfig{}
 }
 
 func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
@@ -52,3 +54,22 @@ func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
 func (conf *ConsumerConfig) Index() string {
 	return conf.AccessKey
 }
+
+type CustomConfig struct {
+	Config
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	if conf.NonceHeader != "" && conf.ClockSkew == nil {
+		return errors.New("clock_skew is required when nonce_header is set")
+	}
+	if conf.ReplayCache != nil && conf.NonceHeader == "" {
+		return errors.New("nonce_header is required when the replay cache is set")
+	}
+	return nil
+}
@@ -35,6 +35,229 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on LocalReplayCache with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocalReplayCache) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocalReplayCache with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocalReplayCacheMultiError, or nil if none found.
+func (m *LocalReplayCache) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocalReplayCache) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Capacity
+
+	if len(errors) > 0 {
+		return LocalReplayCacheMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalReplayCacheMultiError is an error wrapping multiple validation errors
+// returned by LocalReplayCache.ValidateAll() if the designated constraints
+// aren't met.
+type LocalReplayCacheMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalReplayCacheMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalReplayCacheMultiError) AllErrors() []error { return m }
+
+// LocalReplayCacheValidationError is the validation error returned by
+// LocalReplayCache.Validate if the designated constraints aren't met.
+type LocalReplayCacheValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalReplayCacheValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalReplayCacheValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalReplayCacheValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalReplayCacheValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalReplayCacheValidationError) ErrorName() string { return "LocalReplayCacheValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalReplayCacheValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalReplayCache.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalReplayCacheValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalReplayCacheValidationError{}
+
+// Validate checks the field values on RedisReplayCache with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RedisReplayCache) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedisReplayCache with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedisReplayCacheMultiError, or nil if none found.
+func (m *RedisReplayCache) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedisReplayCache) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := RedisReplayCacheValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for Prefix
+
+	if len(errors) > 0 {
+		return RedisReplayCacheMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedisReplayCacheMultiError is an error wrapping multiple validation errors
+// returned by RedisReplayCache.ValidateAll() if the designated constraints
+// aren't met.
+type RedisReplayCacheMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedisReplayCacheMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedisReplayCacheMultiError) AllErrors() []error { return m }
+
+// RedisReplayCacheValidationError is the validation error returned by
+// RedisReplayCache.Validate if the designated constraints aren't met.
+type RedisReplayCacheValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedisReplayCacheValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedisReplayCacheValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedisReplayCacheValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedisReplayCacheValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedisReplayCacheValidationError) ErrorName() string { return "RedisReplayCacheValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedisReplayCacheValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedisReplayCache.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedisReplayCacheValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedisReplayCacheValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -62,6 +285,145 @@ func (m *Config) validate(all bool) error {
 
 	// no validation rules for DateHeader
 
+	if d := m.GetClockSkew(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ConfigValidationError{
+				field:  "ClockSkew",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ConfigValidationError{
+					field:  "ClockSkew",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for NonceHeader
+
+	for idx, item := range m.GetSignedHeaders() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ConfigValidationError{
+				field:  fmt.Sprintf("SignedHeaders[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for BodyDigest
+
+	// no validation rules for AlgorithmHeader
+
+	switch v := m.ReplayCache.(type) {
+	case *Config_LocalReplayCache:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "ReplayCache",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLocalReplayCache()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "LocalReplayCache",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "LocalReplayCache",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLocalReplayCache()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "LocalReplayCache",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_RedisReplayCache:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "ReplayCache",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRedisReplayCache()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "RedisReplayCache",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "RedisReplayCache",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRedisReplayCache()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "RedisReplayCache",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -201,6 +563,22 @@ func (m *ConsumerConfig) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetAllowedAlgorithms() {
+		_, _ = idx, item
+
+		if _, ok := Algorithm_name[int32(item)]; !ok {
+			err := ConsumerConfigValidationError{
+				field:  fmt.Sprintf("AllowedAlgorithms[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ConsumerConfigMultiError(errors)
 	}
@@ -84,15 +84,27 @@ func (conf *CustomConfig) Validate() error {
 	}
 
 	for i, rule := range conf.Rules {
-		if rule.Key == "" {
-			continue
+		if rule.Key != "" {
+			_, err = expr.CompileCel(rule.Key, cel.StringType)
+			if err != nil {
+				return fmt.Errorf("bad rule %d: %w", i, err)
+			}
 		}
-		_, err = expr.CompileCel(rule.Key, cel.StringType)
-		if err != nil {
-			return fmt.Errorf("bad rule %d: %w", i, err)
+		if rule.Cost != "" {
+			if rule.Algorithm == Rule_SLIDING_WINDOW_LOG {
+				return fmt.Errorf("bad rule %d: cost is not supported by the SLIDING_WINDOW_LOG algorithm", i)
+			}
+			_, err = expr.CompileCel(rule.Cost, cel.IntType)
+			if err != nil {
+				return fmt.Errorf("bad rule %d: %w", i, err)
+			}
 		}
 	}
 
+	if conf.FailureModeDeny && conf.LocalFallback != nil {
+		return fmt.Errorf("failureModeDeny and localFallback can't be configured together")
+	}
+
 	if conf.Username != "" && conf.Password == "" {
 		return fmt.Errorf("password is required when username is set")
 	}
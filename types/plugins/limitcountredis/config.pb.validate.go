@@ -114,6 +114,10 @@ func (m *Rule) validate(all bool) error {
 
 	// no validation rules for Key
 
+	// no validation rules for Algorithm
+
+	// no validation rules for Cost
+
 	if len(errors) > 0 {
 		return RuleMultiError(errors)
 	}
@@ -300,6 +304,147 @@ var _ interface {
 	ErrorName() string
 } = ClusterValidationError{}
 
+// Validate checks the field values on LocalFallback with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LocalFallback) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocalFallback with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocalFallbackMultiError, or
+// nil if none found.
+func (m *LocalFallback) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocalFallback) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetExpectedReplicas() < 1 {
+		err := LocalFallbackValidationError{
+			field:  "ExpectedReplicas",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetProbeInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = LocalFallbackValidationError{
+				field:  "ProbeInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := LocalFallbackValidationError{
+					field:  "ProbeInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return LocalFallbackMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalFallbackMultiError is an error wrapping multiple validation errors
+// returned by LocalFallback.ValidateAll() if the designated constraints
+// aren't met.
+type LocalFallbackMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalFallbackMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalFallbackMultiError) AllErrors() []error { return m }
+
+// LocalFallbackValidationError is the validation error returned by
+// LocalFallback.Validate if the designated constraints aren't met.
+type LocalFallbackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalFallbackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalFallbackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalFallbackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalFallbackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalFallbackValidationError) ErrorName() string { return "LocalFallbackValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalFallbackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalFallback.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalFallbackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalFallbackValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -395,6 +540,37 @@ func (m *Config) validate(all bool) error {
 
 	// no validation rules for DisableXEnvoyRatelimitedHeader
 
+	if all {
+		switch v := interface{}(m.GetLocalFallback()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "LocalFallback",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "LocalFallback",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalFallback()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "LocalFallback",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Hierarchical
+
 	oneofSourcePresent := false
 	switch v := m.Source.(type) {
 	case *Config_Address:
@@ -15,6 +15,9 @@
 package limittoken
 
 import (
+	"fmt"
+	"regexp"
+
 	"mosn.io/htnn/api/pkg/filtermanager/api"
 	"mosn.io/htnn/api/pkg/plugins"
 )
@@ -45,6 +48,13 @@ func (p *Plugin) Config() api.PluginConfig {
 	return &CustomConfig{}
 }
 
+// providers are the tokenizer providers supported by the plugin
+var providers = map[string]bool{
+	"openai":      true,
+	"huggingface": true,
+	"estimate":    true,
+}
+
 type CustomConfig struct {
 	Config
 }
@@ -55,5 +65,32 @@ func (conf *CustomConfig) Validate() error {
 		return err
 	}
 
+	if conf.Tokenizer != "" && len(conf.Tokenizers) > 0 {
+		return fmt.Errorf("tokenizer and tokenizers can't be configured together")
+	}
+	if conf.Tokenizer != "" && !providers[conf.Tokenizer] {
+		return fmt.Errorf("unknown tokenizer provider: %s", conf.Tokenizer)
+	}
+	for i, tk := range conf.Tokenizers {
+		if !providers[tk.Provider] {
+			return fmt.Errorf("bad tokenizer %d: unknown tokenizer provider: %s", i, tk.Provider)
+		}
+		if tk.Model != "" {
+			_, err = regexp.Compile(tk.Model)
+			if err != nil {
+				return fmt.Errorf("bad tokenizer %d: %w", i, err)
+			}
+		}
+		if tk.CharsPerToken < 0 || tk.BytesPerToken < 0 {
+			return fmt.Errorf("bad tokenizer %d: the number of characters or bytes per token can't be negative", i)
+		}
+		if tk.CharsPerToken > 0 && tk.BytesPerToken > 0 {
+			return fmt.Errorf("bad tokenizer %d: charsPerToken and bytesPerToken can't be configured together", i)
+		}
+		if tk.Provider == "huggingface" && tk.Path == "" {
+			return fmt.Errorf("bad tokenizer %d: path is required by the huggingface provider", i)
+		}
+	}
+
 	return nil
 }
@@ -14,20 +14,21 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.9
-// 	protoc        (unknown)
-// source: types/plugins/limitToken/config.proto
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/limittoken/config.proto
 
 package limittoken
 
 import (
 	reflect "reflect"
 	sync "sync"
-	unsafe "unsafe"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
 )
 
 const (
@@ -39,27 +40,34 @@ const (
 
 // Config is the top-level configuration structure for the limittoken plugin.
 type Config struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	RejectedCode int32                  `protobuf:"varint,1,opt,name=rejected_code,json=rejectedCode,proto3" json:"rejected_code,omitempty"`
-	RejectedMsg  string                 `protobuf:"bytes,2,opt,name=rejected_msg,json=rejectedMsg,proto3" json:"rejected_msg,omitempty"`
-	Rule         *Rule                  `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
-	Redis        *RedisConfig           `protobuf:"bytes,4,opt,name=redis,proto3" json:"redis,omitempty"`
-	TokenStats   *TokenStatsConfig      `protobuf:"bytes,5,opt,name=token_stats,json=tokenStats,proto3" json:"token_stats,omitempty"`
-	Tokenizer    string                 `protobuf:"bytes,6,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
-	// Types that are valid to be assigned to ExtractorConfig:
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RejectedCode int32             `protobuf:"varint,1,opt,name=rejected_code,json=rejectedCode,proto3" json:"rejected_code,omitempty"`
+	RejectedMsg  string            `protobuf:"bytes,2,opt,name=rejected_msg,json=rejectedMsg,proto3" json:"rejected_msg,omitempty"`
+	Rule         *Rule             `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Redis        *RedisConfig      `protobuf:"bytes,4,opt,name=redis,proto3" json:"redis,omitempty"`
+	TokenStats   *TokenStatsConfig `protobuf:"bytes,5,opt,name=token_stats,json=tokenStats,proto3" json:"token_stats,omitempty"`
+	Tokenizer    string            `protobuf:"bytes,6,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	// Types that are assignable to ExtractorConfig:
 	//
 	//	*Config_GjsonConfig
+	//	*Config_PresetConfig
 	ExtractorConfig  isConfig_ExtractorConfig `protobuf_oneof:"extractor_config"`
 	StreamingEnabled bool                     `protobuf:"varint,7,opt,name=streaming_enabled,json=streamingEnabled,proto3" json:"streaming_enabled,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The tokenizers to count the tokens. The first one whose `model` matches the model of the
+	// request is used. It can't be configured with `tokenizer`.
+	Tokenizers []*TokenizerConfig `protobuf:"bytes,8,rep,name=tokenizers,proto3" json:"tokenizers,omitempty"`
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *Config) String() string {
@@ -69,8 +77,8 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[0]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -82,7 +90,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{0}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Config) GetRejectedCode() int32 {
@@ -127,18 +135,23 @@ func (x *Config) GetTokenizer() string {
 	return ""
 }
 
-func (x *Config) GetExtractorConfig() isConfig_ExtractorConfig {
-	if x != nil {
-		return x.ExtractorConfig
+func (m *Config) GetExtractorConfig() isConfig_ExtractorConfig {
+	if m != nil {
+		return m.ExtractorConfig
 	}
 	return nil
 }
 
 func (x *Config) GetGjsonConfig() *GjsonConfig {
-	if x != nil {
-		if x, ok := x.ExtractorConfig.(*Config_GjsonConfig); ok {
-			return x.GjsonConfig
-		}
+	if x, ok := x.GetExtractorConfig().(*Config_GjsonConfig); ok {
+		return x.GjsonConfig
+	}
+	return nil
+}
+
+func (x *Config) GetPresetConfig() *PresetConfig {
+	if x, ok := x.GetExtractorConfig().(*Config_PresetConfig); ok {
+		return x.PresetConfig
 	}
 	return nil
 }
@@ -150,6 +163,13 @@ func (x *Config) GetStreamingEnabled() bool {
 	return false
 }
 
+func (x *Config) GetTokenizers() []*TokenizerConfig {
+	if x != nil {
+		return x.Tokenizers
+	}
+	return nil
+}
+
 type isConfig_ExtractorConfig interface {
 	isConfig_ExtractorConfig()
 }
@@ -158,11 +178,106 @@ type Config_GjsonConfig struct {
 	GjsonConfig *GjsonConfig `protobuf:"bytes,100,opt,name=gjson_config,json=gjsonConfig,proto3,oneof"`
 }
 
+type Config_PresetConfig struct {
+	PresetConfig *PresetConfig `protobuf:"bytes,101,opt,name=preset_config,json=presetConfig,proto3,oneof"`
+}
+
 func (*Config_GjsonConfig) isConfig_ExtractorConfig() {}
 
+func (*Config_PresetConfig) isConfig_ExtractorConfig() {}
+
+type TokenizerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the tokenizer, like "openai", "huggingface" or "estimate".
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// A regular expression to match the model extracted with `request_model_path`. Empty matches
+	// all the models.
+	Model string `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// The local path of the HuggingFace `tokenizer.json`. Required by the "huggingface" provider.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The number of characters per token, used by the "estimate" provider. Default to 4.
+	CharsPerToken float32 `protobuf:"fixed32,4,opt,name=chars_per_token,json=charsPerToken,proto3" json:"chars_per_token,omitempty"`
+	// The number of bytes per token, used by the "estimate" provider. When it's set, the bytes are
+	// counted instead of the characters.
+	BytesPerToken float32 `protobuf:"fixed32,5,opt,name=bytes_per_token,json=bytesPerToken,proto3" json:"bytes_per_token,omitempty"`
+}
+
+func (x *TokenizerConfig) Reset() {
+	*x = TokenizerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenizerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizerConfig) ProtoMessage() {}
+
+func (x *TokenizerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizerConfig.ProtoReflect.Descriptor instead.
+func (*TokenizerConfig) Descriptor() ([]byte, []int) {
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TokenizerConfig) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TokenizerConfig) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TokenizerConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TokenizerConfig) GetCharsPerToken() float32 {
+	if x != nil {
+		return x.CharsPerToken
+	}
+	return 0
+}
+
+func (x *TokenizerConfig) GetBytesPerToken() float32 {
+	if x != nil {
+		return x.BytesPerToken
+	}
+	return 0
+}
+
 type Rule struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to LimitBy:
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to LimitBy:
 	//
 	//	*Rule_LimitByHeader
 	//	*Rule_LimitByParam
@@ -173,18 +288,18 @@ type Rule struct {
 	//	*Rule_LimitByPerParam
 	//	*Rule_LimitByPerCookie
 	//	*Rule_LimitByPerConsumer
-	LimitBy       isRule_LimitBy `protobuf_oneof:"limit_by"`
-	Buckets       []*Bucket      `protobuf:"bytes,10,rep,name=buckets,proto3" json:"buckets,omitempty"`
-	Keys          []string       `protobuf:"bytes,11,rep,name=keys,proto3" json:"keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	LimitBy isRule_LimitBy `protobuf_oneof:"limit_by"`
+	Buckets []*Bucket      `protobuf:"bytes,10,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Keys    []string       `protobuf:"bytes,11,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
 func (x *Rule) Reset() {
 	*x = Rule{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *Rule) String() string {
@@ -194,8 +309,8 @@ func (x *Rule) String() string {
 func (*Rule) ProtoMessage() {}
 
 func (x *Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[1]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -207,93 +322,75 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rule.ProtoReflect.Descriptor instead.
 func (*Rule) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{1}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Rule) GetLimitBy() isRule_LimitBy {
-	if x != nil {
-		return x.LimitBy
+func (m *Rule) GetLimitBy() isRule_LimitBy {
+	if m != nil {
+		return m.LimitBy
 	}
 	return nil
 }
 
 func (x *Rule) GetLimitByHeader() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByHeader); ok {
-			return x.LimitByHeader
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByHeader); ok {
+		return x.LimitByHeader
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByParam() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByParam); ok {
-			return x.LimitByParam
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByParam); ok {
+		return x.LimitByParam
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByCookie() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByCookie); ok {
-			return x.LimitByCookie
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByCookie); ok {
+		return x.LimitByCookie
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByConsumer() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByConsumer); ok {
-			return x.LimitByConsumer
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByConsumer); ok {
+		return x.LimitByConsumer
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByPerIp() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByPerIp); ok {
-			return x.LimitByPerIp
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByPerIp); ok {
+		return x.LimitByPerIp
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByPerHeader() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByPerHeader); ok {
-			return x.LimitByPerHeader
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByPerHeader); ok {
+		return x.LimitByPerHeader
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByPerParam() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByPerParam); ok {
-			return x.LimitByPerParam
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByPerParam); ok {
+		return x.LimitByPerParam
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByPerCookie() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByPerCookie); ok {
-			return x.LimitByPerCookie
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByPerCookie); ok {
+		return x.LimitByPerCookie
 	}
 	return ""
 }
 
 func (x *Rule) GetLimitByPerConsumer() string {
-	if x != nil {
-		if x, ok := x.LimitBy.(*Rule_LimitByPerConsumer); ok {
-			return x.LimitByPerConsumer
-		}
+	if x, ok := x.GetLimitBy().(*Rule_LimitByPerConsumer); ok {
+		return x.LimitByPerConsumer
 	}
 	return ""
 }
@@ -371,19 +468,22 @@ func (*Rule_LimitByPerCookie) isRule_LimitBy() {}
 func (*Rule_LimitByPerConsumer) isRule_LimitBy() {}
 
 type Bucket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Burst         int32                  `protobuf:"varint,1,opt,name=burst,proto3" json:"burst,omitempty"`
-	Rate          int32                  `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	Round         int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
-	unknownFields protoimpl.UnknownFields
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Burst int32 `protobuf:"varint,1,opt,name=burst,proto3" json:"burst,omitempty"`
+	Rate  int32 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Round int32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
 }
 
 func (x *Bucket) Reset() {
 	*x = Bucket{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *Bucket) String() string {
@@ -393,8 +493,8 @@ func (x *Bucket) String() string {
 func (*Bucket) ProtoMessage() {}
 
 func (x *Bucket) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[2]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -406,7 +506,7 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{2}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Bucket) GetBurst() int32 {
@@ -431,20 +531,23 @@ func (x *Bucket) GetRound() int32 {
 }
 
 type RedisConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceAddr   string                 `protobuf:"bytes,1,opt,name=service_addr,json=serviceAddr,proto3" json:"service_addr,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Timeout       uint32                 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceAddr string `protobuf:"bytes,1,opt,name=service_addr,json=serviceAddr,proto3" json:"service_addr,omitempty"`
+	Username    string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Timeout     uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (x *RedisConfig) Reset() {
 	*x = RedisConfig{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *RedisConfig) String() string {
@@ -454,8 +557,8 @@ func (x *RedisConfig) String() string {
 func (*RedisConfig) ProtoMessage() {}
 
 func (x *RedisConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[3]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -467,7 +570,7 @@ func (x *RedisConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedisConfig.ProtoReflect.Descriptor instead.
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{3}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RedisConfig) GetServiceAddr() string {
@@ -499,21 +602,24 @@ func (x *RedisConfig) GetTimeout() uint32 {
 }
 
 type TokenStatsConfig struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	WindowSize      int32                  `protobuf:"varint,1,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
-	MinSamples      int32                  `protobuf:"varint,2,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
-	MaxRatio        float32                `protobuf:"fixed32,3,opt,name=max_ratio,json=maxRatio,proto3" json:"max_ratio,omitempty"`
-	MaxTokensPerReq int32                  `protobuf:"varint,4,opt,name=max_tokens_per_req,json=maxTokensPerReq,proto3" json:"max_tokens_per_req,omitempty"`
-	ExceedFactor    float32                `protobuf:"fixed32,5,opt,name=exceed_factor,json=exceedFactor,proto3" json:"exceed_factor,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WindowSize      int32   `protobuf:"varint,1,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	MinSamples      int32   `protobuf:"varint,2,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
+	MaxRatio        float32 `protobuf:"fixed32,3,opt,name=max_ratio,json=maxRatio,proto3" json:"max_ratio,omitempty"`
+	MaxTokensPerReq int32   `protobuf:"varint,4,opt,name=max_tokens_per_req,json=maxTokensPerReq,proto3" json:"max_tokens_per_req,omitempty"`
+	ExceedFactor    float32 `protobuf:"fixed32,5,opt,name=exceed_factor,json=exceedFactor,proto3" json:"exceed_factor,omitempty"`
 }
 
 func (x *TokenStatsConfig) Reset() {
 	*x = TokenStatsConfig{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *TokenStatsConfig) String() string {
@@ -523,8 +629,8 @@ func (x *TokenStatsConfig) String() string {
 func (*TokenStatsConfig) ProtoMessage() {}
 
 func (x *TokenStatsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[4]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -536,7 +642,7 @@ func (x *TokenStatsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenStatsConfig.ProtoReflect.Descriptor instead.
 func (*TokenStatsConfig) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{4}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TokenStatsConfig) GetWindowSize() int32 {
@@ -575,24 +681,27 @@ func (x *TokenStatsConfig) GetExceedFactor() float32 {
 }
 
 type GjsonConfig struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	RequestContentPath           string                 `protobuf:"bytes,1,opt,name=request_content_path,json=requestContentPath,proto3" json:"request_content_path,omitempty"`
-	RequestModelPath             string                 `protobuf:"bytes,2,opt,name=request_model_path,json=requestModelPath,proto3" json:"request_model_path,omitempty"`
-	ResponseContentPath          string                 `protobuf:"bytes,3,opt,name=response_content_path,json=responseContentPath,proto3" json:"response_content_path,omitempty"`
-	ResponseModelPath            string                 `protobuf:"bytes,4,opt,name=response_model_path,json=responseModelPath,proto3" json:"response_model_path,omitempty"`
-	ResponseCompletionTokensPath string                 `protobuf:"bytes,5,opt,name=response_completion_tokens_path,json=responseCompletionTokensPath,proto3" json:"response_completion_tokens_path,omitempty"`
-	ResponsePromptTokensPath     string                 `protobuf:"bytes,6,opt,name=response_prompt_tokens_path,json=responsePromptTokensPath,proto3" json:"response_prompt_tokens_path,omitempty"`
-	StreamResponseContentPath    string                 `protobuf:"bytes,7,opt,name=stream_response_content_path,json=streamResponseContentPath,proto3" json:"stream_response_content_path,omitempty"`
-	StreamResponseModelPath      string                 `protobuf:"bytes,8,opt,name=stream_response_model_path,json=streamResponseModelPath,proto3" json:"stream_response_model_path,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestContentPath           string `protobuf:"bytes,1,opt,name=request_content_path,json=requestContentPath,proto3" json:"request_content_path,omitempty"`
+	RequestModelPath             string `protobuf:"bytes,2,opt,name=request_model_path,json=requestModelPath,proto3" json:"request_model_path,omitempty"`
+	ResponseContentPath          string `protobuf:"bytes,3,opt,name=response_content_path,json=responseContentPath,proto3" json:"response_content_path,omitempty"`
+	ResponseModelPath            string `protobuf:"bytes,4,opt,name=response_model_path,json=responseModelPath,proto3" json:"response_model_path,omitempty"`
+	ResponseCompletionTokensPath string `protobuf:"bytes,5,opt,name=response_completion_tokens_path,json=responseCompletionTokensPath,proto3" json:"response_completion_tokens_path,omitempty"`
+	ResponsePromptTokensPath     string `protobuf:"bytes,6,opt,name=response_prompt_tokens_path,json=responsePromptTokensPath,proto3" json:"response_prompt_tokens_path,omitempty"`
+	StreamResponseContentPath    string `protobuf:"bytes,7,opt,name=stream_response_content_path,json=streamResponseContentPath,proto3" json:"stream_response_content_path,omitempty"`
+	StreamResponseModelPath      string `protobuf:"bytes,8,opt,name=stream_response_model_path,json=streamResponseModelPath,proto3" json:"stream_response_model_path,omitempty"`
 }
 
 func (x *GjsonConfig) Reset() {
 	*x = GjsonConfig{}
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
 func (x *GjsonConfig) String() string {
@@ -602,8 +711,8 @@ func (x *GjsonConfig) String() string {
 func (*GjsonConfig) ProtoMessage() {}
 
 func (x *GjsonConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_plugins_limitToken_config_proto_msgTypes[5]
-	if x != nil {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -615,7 +724,7 @@ func (x *GjsonConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GjsonConfig.ProtoReflect.Descriptor instead.
 func (*GjsonConfig) Descriptor() ([]byte, []int) {
-	return file_types_plugins_limitToken_config_proto_rawDescGZIP(), []int{5}
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GjsonConfig) GetRequestContentPath() string {
@@ -674,107 +783,361 @@ func (x *GjsonConfig) GetStreamResponseModelPath() string {
 	return ""
 }
 
-var File_types_plugins_limitToken_config_proto protoreflect.FileDescriptor
-
-const file_types_plugins_limitToken_config_proto_rawDesc = "" +
-	"\n" +
-	"%types/plugins/limitToken/config.proto\x12\x18types.plugins.limittoken\x1a\x17validate/validate.proto\"\xc3\x03\n" +
-	"\x06Config\x12#\n" +
-	"\rrejected_code\x18\x01 \x01(\x05R\frejectedCode\x12!\n" +
-	"\frejected_msg\x18\x02 \x01(\tR\vrejectedMsg\x122\n" +
-	"\x04rule\x18\x03 \x01(\v2\x1e.types.plugins.limittoken.RuleR\x04rule\x12;\n" +
-	"\x05redis\x18\x04 \x01(\v2%.types.plugins.limittoken.RedisConfigR\x05redis\x12K\n" +
-	"\vtoken_stats\x18\x05 \x01(\v2*.types.plugins.limittoken.TokenStatsConfigR\n" +
-	"tokenStats\x12\x1c\n" +
-	"\ttokenizer\x18\x06 \x01(\tR\ttokenizer\x12T\n" +
-	"\fgjson_config\x18d \x01(\v2%.types.plugins.limittoken.GjsonConfigB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\vgjsonConfig\x12+\n" +
-	"\x11streaming_enabled\x18\a \x01(\bR\x10streamingEnabledB\x12\n" +
-	"\x10extractor_config\"\xfb\x03\n" +
-	"\x04Rule\x12(\n" +
-	"\x0flimit_by_header\x18\x01 \x01(\tH\x00R\rlimitByHeader\x12&\n" +
-	"\x0elimit_by_param\x18\x02 \x01(\tH\x00R\flimitByParam\x12(\n" +
-	"\x0flimit_by_cookie\x18\x03 \x01(\tH\x00R\rlimitByCookie\x12,\n" +
-	"\x11limit_by_consumer\x18\x04 \x01(\tH\x00R\x0flimitByConsumer\x12'\n" +
-	"\x0flimit_by_per_ip\x18\x05 \x01(\tH\x00R\flimitByPerIp\x12/\n" +
-	"\x13limit_by_per_header\x18\x06 \x01(\tH\x00R\x10limitByPerHeader\x12-\n" +
-	"\x12limit_by_per_param\x18\a \x01(\tH\x00R\x0flimitByPerParam\x12/\n" +
-	"\x13limit_by_per_cookie\x18\b \x01(\tH\x00R\x10limitByPerCookie\x123\n" +
-	"\x15limit_by_per_consumer\x18\t \x01(\tH\x00R\x12limitByPerConsumer\x12:\n" +
-	"\abuckets\x18\n" +
-	" \x03(\v2 .types.plugins.limittoken.BucketR\abuckets\x12\x12\n" +
-	"\x04keys\x18\v \x03(\tR\x04keysB\n" +
-	"\n" +
-	"\blimit_by\"H\n" +
-	"\x06Bucket\x12\x14\n" +
-	"\x05burst\x18\x01 \x01(\x05R\x05burst\x12\x12\n" +
-	"\x04rate\x18\x02 \x01(\x05R\x04rate\x12\x14\n" +
-	"\x05round\x18\x03 \x01(\x05R\x05round\"\x8b\x01\n" +
-	"\vRedisConfig\x12*\n" +
-	"\fservice_addr\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vserviceAddr\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x18\n" +
-	"\atimeout\x18\x04 \x01(\rR\atimeout\"\xc3\x01\n" +
-	"\x10TokenStatsConfig\x12\x1f\n" +
-	"\vwindow_size\x18\x01 \x01(\x05R\n" +
-	"windowSize\x12\x1f\n" +
-	"\vmin_samples\x18\x02 \x01(\x05R\n" +
-	"minSamples\x12\x1b\n" +
-	"\tmax_ratio\x18\x03 \x01(\x02R\bmaxRatio\x12+\n" +
-	"\x12max_tokens_per_req\x18\x04 \x01(\x05R\x0fmaxTokensPerReq\x12#\n" +
-	"\rexceed_factor\x18\x05 \x01(\x02R\fexceedFactor\"\xf9\x03\n" +
-	"\vGjsonConfig\x129\n" +
-	"\x14request_content_path\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x12requestContentPath\x125\n" +
-	"\x12request_model_path\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x10requestModelPath\x12;\n" +
-	"\x15response_content_path\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x13responseContentPath\x127\n" +
-	"\x13response_model_path\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x11responseModelPath\x12E\n" +
-	"\x1fresponse_completion_tokens_path\x18\x05 \x01(\tR\x1cresponseCompletionTokensPath\x12=\n" +
-	"\x1bresponse_prompt_tokens_path\x18\x06 \x01(\tR\x18responsePromptTokensPath\x12?\n" +
-	"\x1cstream_response_content_path\x18\a \x01(\tR\x19streamResponseContentPath\x12;\n" +
-	"\x1astream_response_model_path\x18\b \x01(\tR\x17streamResponseModelPathB'Z%mosn.io/htnn/types/plugins/limittokenb\x06proto3"
+// Extract the content, model and token usage with the built-in preset of the LLM API.
+type PresetConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol v1.LLMProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=types.plugins.api.v1.LLMProtocol" json:"protocol,omitempty"`
+}
+
+func (x *PresetConfig) Reset() {
+	*x = PresetConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_limittoken_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresetConfig) ProtoMessage() {}
+
+func (x *PresetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_limittoken_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresetConfig.ProtoReflect.Descriptor instead.
+func (*PresetConfig) Descriptor() ([]byte, []int) {
+	return file_types_plugins_limittoken_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PresetConfig) GetProtocol() v1.LLMProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return v1.LLMProtocol(0)
+}
+
+var File_types_plugins_limittoken_config_proto protoreflect.FileDescriptor
+
+var file_types_plugins_limittoken_config_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x04, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x32, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x64, 0x69,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x4b,
+	0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x47, 0x6a, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x6a, 0x73, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x49, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x42, 0x17, 0x0a, 0x10,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69,
+	0x7a, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x50, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xfb, 0x03, 0x0a, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x5f,
+	0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50,
+	0x65, 0x72, 0x49, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62,
+	0x79, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x2f, 0x0a, 0x13, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50, 0x65, 0x72, 0x43,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62,
+	0x79, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x50,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x22, 0x48, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2a, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xc3,
+	0x01, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0xf9, 0x03, 0x0a, 0x0b, 0x47, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x12, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x35, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x13,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x1f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x1b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x4d, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42,
+	0x27, 0x5a, 0x25, 0x6d, 0x6f, 0x73, 0x6e, 0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
 
 var (
-	file_types_plugins_limitToken_config_proto_rawDescOnce sync.Once
-	file_types_plugins_limitToken_config_proto_rawDescData []byte
+	file_types_plugins_limittoken_config_proto_rawDescOnce sync.Once
+	file_types_plugins_limittoken_config_proto_rawDescData = file_types_plugins_limittoken_config_proto_rawDesc
 )
 
-func file_types_plugins_limitToken_config_proto_rawDescGZIP() []byte {
-	file_types_plugins_limitToken_config_proto_rawDescOnce.Do(func() {
-		file_types_plugins_limitToken_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_types_plugins_limitToken_config_proto_rawDesc), len(file_types_plugins_limitToken_config_proto_rawDesc)))
+func file_types_plugins_limittoken_config_proto_rawDescGZIP() []byte {
+	file_types_plugins_limittoken_config_proto_rawDescOnce.Do(func() {
+		file_types_plugins_limittoken_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_limittoken_config_proto_rawDescData)
 	})
-	return file_types_plugins_limitToken_config_proto_rawDescData
+	return file_types_plugins_limittoken_config_proto_rawDescData
 }
 
-var file_types_plugins_limitToken_config_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_types_plugins_limitToken_config_proto_goTypes = []any{
+var file_types_plugins_limittoken_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_types_plugins_limittoken_config_proto_goTypes = []interface{}{
 	(*Config)(nil),           // 0: types.plugins.limittoken.Config
-	(*Rule)(nil),             // 1: types.plugins.limittoken.Rule
-	(*Bucket)(nil),           // 2: types.plugins.limittoken.Bucket
-	(*RedisConfig)(nil),      // 3: types.plugins.limittoken.RedisConfig
-	(*TokenStatsConfig)(nil), // 4: types.plugins.limittoken.TokenStatsConfig
-	(*GjsonConfig)(nil),      // 5: types.plugins.limittoken.GjsonConfig
-}
-var file_types_plugins_limitToken_config_proto_depIdxs = []int32{
-	1, // 0: types.plugins.limittoken.Config.rule:type_name -> types.plugins.limittoken.Rule
-	3, // 1: types.plugins.limittoken.Config.redis:type_name -> types.plugins.limittoken.RedisConfig
-	4, // 2: types.plugins.limittoken.Config.token_stats:type_name -> types.plugins.limittoken.TokenStatsConfig
-	5, // 3: types.plugins.limittoken.Config.gjson_config:type_name -> types.plugins.limittoken.GjsonConfig
-	2, // 4: types.plugins.limittoken.Rule.buckets:type_name -> types.plugins.limittoken.Bucket
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
-}
-
-func init() { file_types_plugins_limitToken_config_proto_init() }
-func file_types_plugins_limitToken_config_proto_init() {
-	if File_types_plugins_limitToken_config_proto != nil {
+	(*TokenizerConfig)(nil),  // 1: types.plugins.limittoken.TokenizerConfig
+	(*Rule)(nil),             // 2: types.plugins.limittoken.Rule
+	(*Bucket)(nil),           // 3: types.plugins.limittoken.Bucket
+	(*RedisConfig)(nil),      // 4: types.plugins.limittoken.RedisConfig
+	(*TokenStatsConfig)(nil), // 5: types.plugins.limittoken.TokenStatsConfig
+	(*GjsonConfig)(nil),      // 6: types.plugins.limittoken.GjsonConfig
+	(*PresetConfig)(nil),     // 7: types.plugins.limittoken.PresetConfig
+	(v1.LLMProtocol)(0),      // 8: types.plugins.api.v1.LLMProtocol
+}
+var file_types_plugins_limittoken_config_proto_depIdxs = []int32{
+	2, // 0: types.plugins.limittoken.Config.rule:type_name -> types.plugins.limittoken.Rule
+	4, // 1: types.plugins.limittoken.Config.redis:type_name -> types.plugins.limittoken.RedisConfig
+	5, // 2: types.plugins.limittoken.Config.token_stats:type_name -> types.plugins.limittoken.TokenStatsConfig
+	6, // 3: types.plugins.limittoken.Config.gjson_config:type_name -> types.plugins.limittoken.GjsonConfig
+	7, // 4: types.plugins.limittoken.Config.preset_config:type_name -> types.plugins.limittoken.PresetConfig
+	1, // 5: types.plugins.limittoken.Config.tokenizers:type_name -> types.plugins.limittoken.TokenizerConfig
+	3, // 6: types.plugins.limittoken.Rule.buckets:type_name -> types.plugins.limittoken.Bucket
+	8, // 7: types.plugins.limittoken.PresetConfig.protocol:type_name -> types.plugins.api.v1.LLMProtocol
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_limittoken_config_proto_init() }
+func file_types_plugins_limittoken_config_proto_init() {
+	if File_types_plugins_limittoken_config_proto != nil {
 		return
 	}
-	file_types_plugins_limitToken_config_proto_msgTypes[0].OneofWrappers = []any{
+	if !protoimpl.UnsafeEnabled {
+		file_types_plugins_limittoken_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenizerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedisConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenStatsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GjsonConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_limittoken_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresetConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_limittoken_config_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Config_GjsonConfig)(nil),
+		(*Config_PresetConfig)(nil),
 	}
-	file_types_plugins_limitToken_config_proto_msgTypes[1].OneofWrappers = []any{
+	file_types_plugins_limittoken_config_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Rule_LimitByHeader)(nil),
 		(*Rule_LimitByParam)(nil),
 		(*Rule_LimitByCookie)(nil),
@@ -789,17 +1152,18 @@ func file_types_plugins_limitToken_config_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_plugins_limitToken_config_proto_rawDesc), len(file_types_plugins_limitToken_config_proto_rawDesc)),
+			RawDescriptor: file_types_plugins_limittoken_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_types_plugins_limitToken_config_proto_goTypes,
-		DependencyIndexes: file_types_plugins_limitToken_config_proto_depIdxs,
-		MessageInfos:      file_types_plugins_limitToken_config_proto_msgTypes,
+		GoTypes:           file_types_plugins_limittoken_config_proto_goTypes,
+		DependencyIndexes: file_types_plugins_limittoken_config_proto_depIdxs,
+		MessageInfos:      file_types_plugins_limittoken_config_proto_msgTypes,
 	}.Build()
-	File_types_plugins_limitToken_config_proto = out.File
-	file_types_plugins_limitToken_config_proto_goTypes = nil
-	file_types_plugins_limitToken_config_proto_depIdxs = nil
+	File_types_plugins_limittoken_config_proto = out.File
+	file_types_plugins_limittoken_config_proto_rawDesc = nil
+	file_types_plugins_limittoken_config_proto_goTypes = nil
+	file_types_plugins_limittoken_config_proto_depIdxs = nil
 }
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-validate. DO NOT EDIT.
-// source: types/plugins/limitToken/config.proto
+// source: types/plugins/limittoken/config.proto
 
 package limittoken
 
@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	v1 "mosn.io/htnn/types/plugins/api/v1"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = v1.LLMProtocol(0)
 )
 
 // Validate checks the field values on Config with the rules defined in the
@@ -151,6 +155,41 @@ func (m *Config) validate(all bool) error {
 
 	// no validation rules for StreamingEnabled
 
+	for idx, item := range m.GetTokenizers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("Tokenizers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("Tokenizers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("Tokenizers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	oneofExtractorConfigPresent := false
 	switch v := m.ExtractorConfig.(type) {
 	case *Config_GjsonConfig:
 		if v == nil {
@@ -163,24 +202,56 @@ func (m *Config) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
+		oneofExtractorConfigPresent = true
 
-		if m.GetGjsonConfig() == nil {
+		if all {
+			switch v := interface{}(m.GetGjsonConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "GjsonConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "GjsonConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGjsonConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "GjsonConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_PresetConfig:
+		if v == nil {
 			err := ConfigValidationError{
-				field:  "GjsonConfig",
-				reason: "value is required",
+				field:  "ExtractorConfig",
+				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
 		}
+		oneofExtractorConfigPresent = true
 
 		if all {
-			switch v := interface{}(m.GetGjsonConfig()).(type) {
+			switch v := interface{}(m.GetPresetConfig()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ConfigValidationError{
-						field:  "GjsonConfig",
+						field:  "PresetConfig",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -188,16 +259,16 @@ func (m *Config) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ConfigValidationError{
-						field:  "GjsonConfig",
+						field:  "PresetConfig",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetGjsonConfig()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPresetConfig()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ConfigValidationError{
-					field:  "GjsonConfig",
+					field:  "PresetConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -207,6 +278,16 @@ func (m *Config) validate(all bool) error {
 	default:
 		_ = v // ensures v is used
 	}
+	if !oneofExtractorConfigPresent {
+		err := ConfigValidationError{
+			field:  "ExtractorConfig",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
@@ -221,7 +302,7 @@ type ConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m ConfigMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -285,6 +366,125 @@ var _ interface {
 	ErrorName() string
 } = ConfigValidationError{}
 
+// Validate checks the field values on TokenizerConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TokenizerConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenizerConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenizerConfigMultiError, or nil if none found.
+func (m *TokenizerConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenizerConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProvider()) < 1 {
+		err := TokenizerConfigValidationError{
+			field:  "Provider",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Model
+
+	// no validation rules for Path
+
+	// no validation rules for CharsPerToken
+
+	// no validation rules for BytesPerToken
+
+	if len(errors) > 0 {
+		return TokenizerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenizerConfigMultiError is an error wrapping multiple validation errors
+// returned by TokenizerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type TokenizerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenizerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenizerConfigMultiError) AllErrors() []error { return m }
+
+// TokenizerConfigValidationError is the validation error returned by
+// TokenizerConfig.Validate if the designated constraints aren't met.
+type TokenizerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenizerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenizerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenizerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenizerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenizerConfigValidationError) ErrorName() string { return "TokenizerConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenizerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenizerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenizerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenizerConfigValidationError{}
+
 // Validate checks the field values on Rule with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -466,7 +666,7 @@ type RuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m RuleMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -570,7 +770,7 @@ type BucketMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m BucketMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -686,7 +886,7 @@ type RedisConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m RedisConfigMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -796,7 +996,7 @@ type TokenStatsConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m TokenStatsConfigMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -947,7 +1147,7 @@ type GjsonConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m GjsonConfigMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
+	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1010,3 +1210,104 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GjsonConfigValidationError{}
+
+// Validate checks the field values on PresetConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PresetConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresetConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresetConfigMultiError, or
+// nil if none found.
+func (m *PresetConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresetConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	if len(errors) > 0 {
+		return PresetConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresetConfigMultiError is an error wrapping multiple validation errors
+// returned by PresetConfig.ValidateAll() if the designated constraints aren't met.
+type PresetConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresetConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresetConfigMultiError) AllErrors() []error { return m }
+
+// PresetConfigValidationError is the validation error returned by
+// PresetConfig.Validate if the designated constraints aren't met.
+type PresetConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresetConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresetConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresetConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresetConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresetConfigValidationError) ErrorName() string { return "PresetConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresetConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresetConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresetConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresetConfigValidationError{}
@@ -0,0 +1,96 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtlsauth
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+)
+
+const (
+	Name = "mtlsAuth"
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &Config{}
+}
+
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &CustomConsumerConfig{}
+}
+
+var fingerprintRegex = regexp.MustCompile("^([0-9A-Fa-f]{2}:?){31}[0-9A-Fa-f]{2}$")
+
+type CustomConsumerConfig struct {
+	ConsumerConfig
+}
+
+func (conf *CustomConsumerConfig) Validate() error {
+	err := conf.ConsumerConfig.Validate()
+	if err != nil {
+		return err
+	}
+
+	switch id := conf.Identity.(type) {
+	case *ConsumerConfig_Fingerprint:
+		if !fingerprintRegex.MatchString(id.Fingerprint) {
+			return errors.New("invalid fingerprint: should be the SHA256 digest in hex format")
+		}
+	case *ConsumerConfig_San:
+		if id.San == "" {
+			return errors.New("invalid san: should not be empty")
+		}
+	}
+	return nil
+}
+
+// FingerprintIndex returns the index of the consumer identified by the fingerprint
+func FingerprintIndex(fingerprint string) string {
+	return "fingerprint:" + strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
+
+// SANIndex returns the index of the consumer identified by the SAN
+func SANIndex(san string) string {
+	return "san:" + san
+}
+
+func (conf *ConsumerConfig) Index() string {
+	if fp := conf.GetFingerprint(); fp != "" {
+		return FingerprintIndex(fp)
+	}
+	return SANIndex(conf.GetSan())
+}
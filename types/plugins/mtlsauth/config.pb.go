@@ -0,0 +1,412 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: types/plugins/mtlsauth/config.proto
+
+package mtlsauth
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CertificateField int32
+
+const (
+	CertificateField_SUBJECT CertificateField = 0
+	CertificateField_URI_SAN CertificateField = 1
+	CertificateField_DNS_SAN CertificateField = 2
+	// The hex-encoded SHA256 digest of the certificate
+	CertificateField_FINGERPRINT CertificateField = 3
+)
+
+// Enum value maps for CertificateField.
+var (
+	CertificateField_name = map[int32]string{
+		0: "SUBJECT",
+		1: "URI_SAN",
+		2: "DNS_SAN",
+		3: "FINGERPRINT",
+	}
+	CertificateField_value = map[string]int32{
+		"SUBJECT":     0,
+		"URI_SAN":     1,
+		"DNS_SAN":     2,
+		"FINGERPRINT": 3,
+	}
+)
+
+func (x CertificateField) Enum() *CertificateField {
+	p := new(CertificateField)
+	*p = x
+	return p
+}
+
+func (x CertificateField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CertificateField) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_plugins_mtlsauth_config_proto_enumTypes[0].Descriptor()
+}
+
+func (CertificateField) Type() protoreflect.EnumType {
+	return &file_types_plugins_mtlsauth_config_proto_enumTypes[0]
+}
+
+func (x CertificateField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CertificateField.Descriptor instead.
+func (CertificateField) EnumDescriptor() ([]byte, []int) {
+	return file_types_plugins_mtlsauth_config_proto_rawDescGZIP(), []int{0}
+}
+
+type ForwardHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field CertificateField `protobuf:"varint,1,opt,name=field,proto3,enum=types.plugins.mtlsauth.CertificateField" json:"field,omitempty"`
+	Name  string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ForwardHeader) Reset() {
+	*x = ForwardHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardHeader) ProtoMessage() {}
+
+func (x *ForwardHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardHeader.ProtoReflect.Descriptor instead.
+func (*ForwardHeader) Descriptor() ([]byte, []int) {
+	return file_types_plugins_mtlsauth_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForwardHeader) GetField() CertificateField {
+	if x != nil {
+		return x.Field
+	}
+	return CertificateField_SUBJECT
+}
+
+func (x *ForwardHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Forward the selected fields of the peer certificate to the upstream as headers.
+	// The headers with the same name in the request are overridden.
+	ForwardHeaders []*ForwardHeader `protobuf:"bytes,1,rep,name=forward_headers,json=forwardHeaders,proto3" json:"forward_headers,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_types_plugins_mtlsauth_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Config) GetForwardHeaders() []*ForwardHeader {
+	if x != nil {
+		return x.ForwardHeaders
+	}
+	return nil
+}
+
+type ConsumerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Identity:
+	//
+	//	*ConsumerConfig_Fingerprint
+	//	*ConsumerConfig_San
+	Identity isConsumerConfig_Identity `protobuf_oneof:"identity"`
+}
+
+func (x *ConsumerConfig) Reset() {
+	*x = ConsumerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerConfig) ProtoMessage() {}
+
+func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_types_plugins_mtlsauth_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerConfig.ProtoReflect.Descriptor instead.
+func (*ConsumerConfig) Descriptor() ([]byte, []int) {
+	return file_types_plugins_mtlsauth_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *ConsumerConfig) GetIdentity() isConsumerConfig_Identity {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+func (x *ConsumerConfig) GetFingerprint() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_Fingerprint); ok {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetSan() string {
+	if x, ok := x.GetIdentity().(*ConsumerConfig_San); ok {
+		return x.San
+	}
+	return ""
+}
+
+type isConsumerConfig_Identity interface {
+	isConsumerConfig_Identity()
+}
+
+type ConsumerConfig_Fingerprint struct {
+	// The SHA256 fingerprint of the certificate, in hex format. The colons are allowed.
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3,oneof"`
+}
+
+type ConsumerConfig_San struct {
+	// The URI SAN or the DNS SAN of the certificate
+	San string `protobuf:"bytes,2,opt,name=san,proto3,oneof"`
+}
+
+func (*ConsumerConfig_Fingerprint) isConsumerConfig_Identity() {}
+
+func (*ConsumerConfig_San) isConsumerConfig_Identity() {}
+
+var File_types_plugins_mtlsauth_config_proto protoreflect.FileDescriptor
+
+var file_types_plugins_mtlsauth_config_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x6d, 0x74, 0x6c, 0x73, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6d, 0x74, 0x6c, 0x73, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6d, 0x74, 0x6c, 0x73, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0xc0, 0x01,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x4e, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x6d, 0x74, 0x6c, 0x73, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x22, 0x59, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x61, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x61, 0x6e, 0x42, 0x0f, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x2a, 0x4a, 0x0a, 0x10,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x52, 0x49, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x4e,
+	0x53, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x4e, 0x47, 0x45,
+	0x52, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x42, 0x25, 0x5a, 0x23, 0x6d, 0x6f, 0x73, 0x6e,
+	0x2e, 0x69, 0x6f, 0x2f, 0x68, 0x74, 0x6e, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x6d, 0x74, 0x6c, 0x73, 0x61, 0x75, 0x74, 0x68, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_plugins_mtlsauth_config_proto_rawDescOnce sync.Once
+	file_types_plugins_mtlsauth_config_proto_rawDescData = file_types_plugins_mtlsauth_config_proto_rawDesc
+)
+
+func file_types_plugins_mtlsauth_config_proto_rawDescGZIP() []byte {
+	file_types_plugins_mtlsauth_config_proto_rawDescOnce.Do(func() {
+		file_types_plugins_mtlsauth_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_plugins_mtlsauth_config_proto_rawDescData)
+	})
+	return file_types_plugins_mtlsauth_config_proto_rawDescData
+}
+
+var file_types_plugins_mtlsauth_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_types_plugins_mtlsauth_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_plugins_mtlsauth_config_proto_goTypes = []interface{}{
+	(CertificateField)(0),  // 0: types.plugins.mtlsauth.CertificateField
+	(*ForwardHeader)(nil),  // 1: types.plugins.mtlsauth.ForwardHeader
+	(*Config)(nil),         // 2: types.plugins.mtlsauth.Config
+	(*ConsumerConfig)(nil), // 3: types.plugins.mtlsauth.ConsumerConfig
+}
+var file_types_plugins_mtlsauth_config_proto_depIdxs = []int32{
+	0, // 0: types.plugins.mtlsauth.ForwardHeader.field:type_name -> types.plugins.mtlsauth.CertificateField
+	1, // 1: types.plugins.mtlsauth.Config.forward_headers:type_name -> types.plugins.mtlsauth.ForwardHeader
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_types_plugins_mtlsauth_config_proto_init() }
+func file_types_plugins_mtlsauth_config_proto_init() {
+	if File_types_plugins_mtlsauth_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_plugins_mtlsauth_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_mtlsauth_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_plugins_mtlsauth_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_types_plugins_mtlsauth_config_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ConsumerConfig_Fingerprint)(nil),
+		(*ConsumerConfig_San)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_plugins_mtlsauth_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_plugins_mtlsauth_config_proto_goTypes,
+		DependencyIndexes: file_types_plugins_mtlsauth_config_proto_depIdxs,
+		EnumInfos:         file_types_plugins_mtlsauth_config_proto_enumTypes,
+		MessageInfos:      file_types_plugins_mtlsauth_config_proto_msgTypes,
+	}.Build()
+	File_types_plugins_mtlsauth_config_proto = out.File
+	file_types_plugins_mtlsauth_config_proto_rawDesc = nil
+	file_types_plugins_mtlsauth_config_proto_goTypes = nil
+	file_types_plugins_mtlsauth_config_proto_depIdxs = nil
+}
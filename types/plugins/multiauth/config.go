@@ -0,0 +1,123 @@
+// Copyright The HTNN Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiauth
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mosn.io/htnn/api/pkg/filtermanager/api"
+	"mosn.io/htnn/api/pkg/plugins"
+	"mosn.io/htnn/types/pkg/proto"
+)
+
+const (
+	Name = "multiAuth"
+)
+
+func init() {
+	plugins.RegisterPluginType(Name, &Plugin{})
+}
+
+type Plugin struct {
+	plugins.PluginMethodDefaultImpl
+}
+
+func (p *Plugin) Type() plugins.PluginType {
+	return plugins.TypeAuthn
+}
+
+func (p *Plugin) Order() plugins.PluginOrder {
+	return plugins.PluginOrder{
+		Position: plugins.OrderPositionAuthn,
+	}
+}
+
+func (p *Plugin) Config() api.PluginConfig {
+	return &CustomConfig{}
+}
+
+// ConsumerConfig makes multiAuth a consumer plugin, so that it runs with the other authn plugins
+// before the consumer is determined. The consumers are configured via the composed plugins.
+func (p *Plugin) ConsumerConfig() api.PluginConsumerConfig {
+	return &ConsumerConfig{}
+}
+
+type ConsumerConfig struct {
+	emptypb.Empty
+}
+
+func (conf *ConsumerConfig) Validate() error {
+	return errors.New("multiAuth can't be configured in the consumer, configure the composed plugins instead")
+}
+
+func (conf *ConsumerConfig) Index() string {
+	return ""
+}
+
+type CustomConfig struct {
+	Config
+}
+
+// bodyDigestConfig is implemented by the config of hmacAuth, which verifies the request body when
+// bodyDigest is set.
+type bodyDigestConfig interface {
+	GetBodyDigest() bool
+}
+
+func (conf *CustomConfig) Validate() error {
+	err := conf.Config.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range conf.Plugins {
+		if p.Name == Name {
+			return errors.New("multiAuth can't be nested")
+		}
+
+		plugin := plugins.LoadPluginType(p.Name)
+		if plugin == nil {
+			return fmt.Errorf("unknown plugin %s", p.Name)
+		}
+		if _, ok := plugin.(plugins.NativePlugin); ok {
+			return fmt.Errorf("plugin %s is not a Go plugin", p.Name)
+		}
+		if plugin.Order().Position != plugins.OrderPositionAuthn {
+			return fmt.Errorf("plugin %s is not an authn plugin", p.Name)
+		}
+
+		pc := plugin.Config()
+		if p.Config != nil {
+			data, err := p.Config.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			if err := proto.UnmarshalJSON(data, pc); err != nil {
+				return fmt.Errorf("failed to unmarshal the config of plugin %s: %w", p.Name, err)
+			}
+		}
+		if err := pc.Validate(); err != nil {
+			return fmt.Errorf("invalid config of plugin %s: %w", p.Name, err)
+		}
+		// The body is not available in the authn phase
+		if c, ok := pc.(bodyDigestConfig); ok && c.GetBodyDigest() {
+			return fmt.Errorf("plugin %s with bodyDigest is not supported", p.Name)
+		}
+	}
+	return nil
+}
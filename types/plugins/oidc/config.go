@@ -65,5 +65,17 @@ func (conf *CustomConfig) Validate() error {
 			reason: "value length must be 16, 24 or 32 bytes",
 		}
 	}
+	if conf.Cookie.GetSameSite() == Cookie_NONE && !conf.Cookie.GetSecure() {
+		return ConfigValidationError{
+			field:  "Cookie",
+			reason: "secure is required when same_site is NONE",
+		}
+	}
+	if conf.BackchannelLogout != nil && conf.SessionStore == nil {
+		return ConfigValidationError{
+			field:  "BackchannelLogout",
+			reason: "a session store is required",
+		}
+	}
 	return nil
 }
@@ -35,6 +35,1072 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on MemorySessionStore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemorySessionStore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemorySessionStore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemorySessionStoreMultiError, or nil if none found.
+func (m *MemorySessionStore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemorySessionStore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Capacity
+
+	if len(errors) > 0 {
+		return MemorySessionStoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemorySessionStoreMultiError is an error wrapping multiple validation errors
+// returned by MemorySessionStore.ValidateAll() if the designated constraints
+// aren't met.
+type MemorySessionStoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemorySessionStoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemorySessionStoreMultiError) AllErrors() []error { return m }
+
+// MemorySessionStoreValidationError is the validation error returned by
+// MemorySessionStore.Validate if the designated constraints aren't met.
+type MemorySessionStoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemorySessionStoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemorySessionStoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemorySessionStoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemorySessionStoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemorySessionStoreValidationError) ErrorName() string {
+	return "MemorySessionStoreValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemorySessionStoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemorySessionStore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemorySessionStoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemorySessionStoreValidationError{}
+
+// Validate checks the field values on RedisSessionStore with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RedisSessionStore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedisSessionStore with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedisSessionStoreMultiError, or nil if none found.
+func (m *RedisSessionStore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedisSessionStore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := RedisSessionStoreValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for Prefix
+
+	if len(errors) > 0 {
+		return RedisSessionStoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedisSessionStoreMultiError is an error wrapping multiple validation errors
+// returned by RedisSessionStore.ValidateAll() if the designated constraints
+// aren't met.
+type RedisSessionStoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedisSessionStoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedisSessionStoreMultiError) AllErrors() []error { return m }
+
+// RedisSessionStoreValidationError is the validation error returned by
+// RedisSessionStore.Validate if the designated constraints aren't met.
+type RedisSessionStoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedisSessionStoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedisSessionStoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedisSessionStoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedisSessionStoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedisSessionStoreValidationError) ErrorName() string {
+	return "RedisSessionStoreValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedisSessionStoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedisSessionStore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedisSessionStoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedisSessionStoreValidationError{}
+
+// Validate checks the field values on Cookie with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Cookie) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Cookie with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CookieMultiError, or nil if none found.
+func (m *Cookie) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Cookie) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Domain
+
+	// no validation rules for Path
+
+	// no validation rules for SameSite
+
+	// no validation rules for Secure
+
+	if len(errors) > 0 {
+		return CookieMultiError(errors)
+	}
+
+	return nil
+}
+
+// CookieMultiError is an error wrapping multiple validation errors returned by
+// Cookie.ValidateAll() if the designated constraints aren't met.
+type CookieMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CookieMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CookieMultiError) AllErrors() []error { return m }
+
+// CookieValidationError is the validation error returned by Cookie.Validate if
+// the designated constraints aren't met.
+type CookieValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CookieValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CookieValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CookieValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CookieValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CookieValidationError) ErrorName() string { return "CookieValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CookieValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCookie.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CookieValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CookieValidationError{}
+
+// Validate checks the field values on Logout with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Logout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Logout with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogoutMultiError, or nil if none found.
+func (m *Logout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Logout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := LogoutValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPostLogoutRedirectUrl() != "" {
+
+		if uri, err := url.Parse(m.GetPostLogoutRedirectUrl()); err != nil {
+			err = LogoutValidationError{
+				field:  "PostLogoutRedirectUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := LogoutValidationError{
+				field:  "PostLogoutRedirectUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LogoutMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutMultiError is an error wrapping multiple validation errors returned by
+// Logout.ValidateAll() if the designated constraints aren't met.
+type LogoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutMultiError) AllErrors() []error { return m }
+
+// LogoutValidationError is the validation error returned by Logout.Validate if
+// the designated constraints aren't met.
+type LogoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutValidationError) ErrorName() string { return "LogoutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutValidationError{}
+
+// Validate checks the field values on BackchannelLogout with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BackchannelLogout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackchannelLogout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackchannelLogoutMultiError, or nil if none found.
+func (m *BackchannelLogout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackchannelLogout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := BackchannelLogoutValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BackchannelLogoutMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackchannelLogoutMultiError is an error wrapping multiple validation errors
+// returned by BackchannelLogout.ValidateAll() if the designated constraints
+// aren't met.
+type BackchannelLogoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackchannelLogoutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackchannelLogoutMultiError) AllErrors() []error { return m }
+
+// BackchannelLogoutValidationError is the validation error returned by
+// BackchannelLogout.Validate if the designated constraints aren't met.
+type BackchannelLogoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackchannelLogoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackchannelLogoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackchannelLogoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackchannelLogoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackchannelLogoutValidationError) ErrorName() string {
+	return "BackchannelLogoutValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackchannelLogoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackchannelLogout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackchannelLogoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackchannelLogoutValidationError{}
+
+// Validate checks the field values on ClaimMatcher with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimMatcher) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimMatcher with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimMatcherMultiError, or
+// nil if none found.
+func (m *ClaimMatcher) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimMatcher) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ClaimMatcherValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMatcher() == nil {
+		err := ClaimMatcherValidationError{
+			field:  "Matcher",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatcher()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimMatcherValidationError{
+					field:  "Matcher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimMatcherValidationError{
+					field:  "Matcher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatcher()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimMatcherValidationError{
+				field:  "Matcher",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClaimMatcherMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimMatcherMultiError is an error wrapping multiple validation errors
+// returned by ClaimMatcher.ValidateAll() if the designated constraints aren't met.
+type ClaimMatcherMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimMatcherMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimMatcherMultiError) AllErrors() []error { return m }
+
+// ClaimMatcherValidationError is the validation error returned by
+// ClaimMatcher.Validate if the designated constraints aren't met.
+type ClaimMatcherValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimMatcherValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimMatcherValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimMatcherValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimMatcherValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimMatcherValidationError) ErrorName() string { return "ClaimMatcherValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimMatcherValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimMatcher.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimMatcherValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimMatcherValidationError{}
+
+// Validate checks the field values on ClaimToHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimToHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimToHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimToHeaderMultiError, or
+// nil if none found.
+func (m *ClaimToHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimToHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClaim()) < 1 {
+		err := ClaimToHeaderValidationError{
+			field:  "Claim",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHeader()) < 1 {
+		err := ClaimToHeaderValidationError{
+			field:  "Header",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClaimToHeader_Header_Pattern.MatchString(m.GetHeader()) {
+		err := ClaimToHeaderValidationError{
+			field:  "Header",
+			reason: "value does not match regex pattern \"^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimToHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimToHeaderMultiError is an error wrapping multiple validation errors
+// returned by ClaimToHeader.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimToHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimToHeaderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimToHeaderMultiError) AllErrors() []error { return m }
+
+// ClaimToHeaderValidationError is the validation error returned by
+// ClaimToHeader.Validate if the designated constraints aren't met.
+type ClaimToHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimToHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimToHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimToHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimToHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimToHeaderValidationError) ErrorName() string { return "ClaimToHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimToHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimToHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimToHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimToHeaderValidationError{}
+
+var _ClaimToHeader_Header_Pattern = regexp.MustCompile("^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$")
+
+// Validate checks the field values on BearerToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BearerToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BearerToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BearerTokenMultiError, or
+// nil if none found.
+func (m *BearerToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BearerToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAudiences() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BearerTokenValidationError{
+				field:  fmt.Sprintf("Audiences[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetRequiredScopes() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BearerTokenValidationError{
+				field:  fmt.Sprintf("RequiredScopes[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetRequiredClaims() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BearerTokenValidationError{
+						field:  fmt.Sprintf("RequiredClaims[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BearerTokenValidationError{
+						field:  fmt.Sprintf("RequiredClaims[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BearerTokenValidationError{
+					field:  fmt.Sprintf("RequiredClaims[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetClaimsToHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BearerTokenValidationError{
+						field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BearerTokenValidationError{
+						field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BearerTokenValidationError{
+					field:  fmt.Sprintf("ClaimsToHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BearerOnly
+
+	if len(errors) > 0 {
+		return BearerTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// BearerTokenMultiError is an error wrapping multiple validation errors
+// returned by BearerToken.ValidateAll() if the designated constraints aren't met.
+type BearerTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BearerTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BearerTokenMultiError) AllErrors() []error { return m }
+
+// BearerTokenValidationError is the validation error returned by
+// BearerToken.Validate if the designated constraints aren't met.
+type BearerTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BearerTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BearerTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BearerTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BearerTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BearerTokenValidationError) ErrorName() string { return "BearerTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BearerTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBearerToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BearerTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BearerTokenValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -207,6 +1273,296 @@ func (m *Config) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetCookie()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Cookie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Cookie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCookie()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Cookie",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLogout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Logout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Logout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLogout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Logout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBackchannelLogout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BackchannelLogout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BackchannelLogout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackchannelLogout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "BackchannelLogout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBearerToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BearerToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "BearerToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBearerToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "BearerToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.SessionStore.(type) {
+	case *Config_MemorySessionStore:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "SessionStore",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMemorySessionStore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "MemorySessionStore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "MemorySessionStore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMemorySessionStore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "MemorySessionStore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_RedisSessionStore:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "SessionStore",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRedisSessionStore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "RedisSessionStore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "RedisSessionStore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRedisSessionStore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "RedisSessionStore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/open-policy-agent/opa/rego"
@@ -86,5 +87,18 @@ func (conf *CustomConfig) Validate() error {
 			return err
 		}
 	}
+
+	bundle := conf.GetBundle()
+	if bundle != nil {
+		if bundle.GetPath() == "" && bundle.GetUrl() == "" {
+			return errors.New("invalid Bundle: either path or url is required")
+		}
+		if bundle.GetUrl() != "" {
+			u, err := url.Parse(bundle.GetUrl())
+			if err != nil || !u.IsAbs() {
+				return errors.New("invalid Bundle.Url: value must be absolute")
+			}
+		}
+	}
 	return nil
 }
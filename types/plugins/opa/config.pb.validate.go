@@ -118,19 +118,880 @@ func (m *Remote) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoteValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoteValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoteValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoteValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoteMultiError is an error wrapping multiple validation errors returned by
+// Remote.ValidateAll() if the designated constraints aren't met.
+type RemoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoteMultiError) AllErrors() []error { return m }
+
+// RemoteValidationError is the validation error returned by Remote.Validate if
+// the designated constraints aren't met.
+type RemoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoteValidationError) ErrorName() string { return "RemoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RemoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoteValidationError{}
+
+// Validate checks the field values on Local with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Local) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Local with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LocalMultiError, or nil if none found.
+func (m *Local) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Local) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetText()) < 1 {
+		err := LocalValidationError{
+			field:  "Text",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LocalMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalMultiError is an error wrapping multiple validation errors returned by
+// Local.ValidateAll() if the designated constraints aren't met.
+type LocalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalMultiError) AllErrors() []error { return m }
+
+// LocalValidationError is the validation error returned by Local.Validate if
+// the designated constraints aren't met.
+type LocalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalValidationError) ErrorName() string { return "LocalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalValidationError{}
+
+// Validate checks the field values on Bundle with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Bundle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bundle with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BundleMultiError, or nil if none found.
+func (m *Bundle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bundle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPolicy()) < 1 {
+		err := BundleValidationError{
+			field:  "Policy",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetPollingInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BundleValidationError{
+				field:  "PollingInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := BundleValidationError{
+					field:  "PollingInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BundleValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := BundleValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BundleValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BundleValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BundleValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BundleValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofSourcePresent := false
+	switch v := m.Source.(type) {
+	case *Bundle_Path:
+		if v == nil {
+			err := BundleValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSourcePresent = true
+		// no validation rules for Path
+	case *Bundle_Url:
+		if v == nil {
+			err := BundleValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSourcePresent = true
+		// no validation rules for Url
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSourcePresent {
+		err := BundleValidationError{
+			field:  "Source",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BundleMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleMultiError is an error wrapping multiple validation errors returned by
+// Bundle.ValidateAll() if the designated constraints aren't met.
+type BundleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleMultiError) AllErrors() []error { return m }
+
+// BundleValidationError is the validation error returned by Bundle.Validate if
+// the designated constraints aren't met.
+type BundleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleValidationError) ErrorName() string { return "BundleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BundleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleValidationError{}
+
+// Validate checks the field values on LogSink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogSink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogSink with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogSinkMultiError, or nil if none found.
+func (m *LogSink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogSink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LogSinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogSinkMultiError is an error wrapping multiple validation errors returned
+// by LogSink.ValidateAll() if the designated constraints aren't met.
+type LogSinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogSinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogSinkMultiError) AllErrors() []error { return m }
+
+// LogSinkValidationError is the validation error returned by LogSink.Validate
+// if the designated constraints aren't met.
+type LogSinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogSinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogSinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogSinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogSinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogSinkValidationError) ErrorName() string { return "LogSinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogSinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogSink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogSinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogSinkValidationError{}
+
+// Validate checks the field values on HttpSink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HttpSink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpSink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HttpSinkMultiError, or nil
+// if none found.
+func (m *HttpSink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpSink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = HttpSinkValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := HttpSinkValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetFlushInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HttpSinkValidationError{
+				field:  "FlushInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HttpSinkValidationError{
+					field:  "FlushInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for BatchSize
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HttpSinkValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HttpSinkValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpSinkValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpSinkValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpSinkValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpSinkValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RemoteMultiError(errors)
+		return HttpSinkMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoteMultiError is an error wrapping multiple validation errors returned by
-// Remote.ValidateAll() if the designated constraints aren't met.
-type RemoteMultiError []error
+// HttpSinkMultiError is an error wrapping multiple validation errors returned
+// by HttpSink.ValidateAll() if the designated constraints aren't met.
+type HttpSinkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoteMultiError) Error() string {
+func (m HttpSinkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -139,11 +1000,11 @@ func (m RemoteMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoteMultiError) AllErrors() []error { return m }
+func (m HttpSinkMultiError) AllErrors() []error { return m }
 
-// RemoteValidationError is the validation error returned by Remote.Validate if
-// the designated constraints aren't met.
-type RemoteValidationError struct {
+// HttpSinkValidationError is the validation error returned by
+// HttpSink.Validate if the designated constraints aren't met.
+type HttpSinkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -151,22 +1012,22 @@ type RemoteValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoteValidationError) Field() string { return e.field }
+func (e HttpSinkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoteValidationError) Reason() string { return e.reason }
+func (e HttpSinkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoteValidationError) Cause() error { return e.cause }
+func (e HttpSinkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoteValidationError) Key() bool { return e.key }
+func (e HttpSinkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoteValidationError) ErrorName() string { return "RemoteValidationError" }
+func (e HttpSinkValidationError) ErrorName() string { return "HttpSinkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoteValidationError) Error() string {
+func (e HttpSinkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -178,14 +1039,14 @@ func (e RemoteValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemote.%s: %s%s",
+		"invalid %sHttpSink.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoteValidationError{}
+var _ error = HttpSinkValidationError{}
 
 var _ interface {
 	Field() string
@@ -193,33 +1054,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoteValidationError{}
+} = HttpSinkValidationError{}
 
-// Validate checks the field values on Local with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on DecisionLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Local) Validate() error {
+func (m *DecisionLog) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Local with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in LocalMultiError, or nil if none found.
-func (m *Local) ValidateAll() error {
+// ValidateAll checks the field values on DecisionLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DecisionLogMultiError, or
+// nil if none found.
+func (m *DecisionLog) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Local) validate(all bool) error {
+func (m *DecisionLog) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetText()) < 1 {
-		err := LocalValidationError{
-			field:  "Text",
-			reason: "value length must be at least 1 runes",
+	oneofSinkPresent := false
+	switch v := m.Sink.(type) {
+	case *DecisionLog_Log:
+		if v == nil {
+			err := DecisionLogValidationError{
+				field:  "Sink",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSinkPresent = true
+
+		if all {
+			switch v := interface{}(m.GetLog()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DecisionLogValidationError{
+						field:  "Log",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DecisionLogValidationError{
+						field:  "Log",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DecisionLogValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DecisionLog_Http:
+		if v == nil {
+			err := DecisionLogValidationError{
+				field:  "Sink",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSinkPresent = true
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DecisionLogValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DecisionLogValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DecisionLogValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSinkPresent {
+		err := DecisionLogValidationError{
+			field:  "Sink",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -228,18 +1179,18 @@ func (m *Local) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LocalMultiError(errors)
+		return DecisionLogMultiError(errors)
 	}
 
 	return nil
 }
 
-// LocalMultiError is an error wrapping multiple validation errors returned by
-// Local.ValidateAll() if the designated constraints aren't met.
-type LocalMultiError []error
+// DecisionLogMultiError is an error wrapping multiple validation errors
+// returned by DecisionLog.ValidateAll() if the designated constraints aren't met.
+type DecisionLogMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LocalMultiError) Error() string {
+func (m DecisionLogMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -248,11 +1199,11 @@ func (m LocalMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LocalMultiError) AllErrors() []error { return m }
+func (m DecisionLogMultiError) AllErrors() []error { return m }
 
-// LocalValidationError is the validation error returned by Local.Validate if
-// the designated constraints aren't met.
-type LocalValidationError struct {
+// DecisionLogValidationError is the validation error returned by
+// DecisionLog.Validate if the designated constraints aren't met.
+type DecisionLogValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -260,22 +1211,22 @@ type LocalValidationError struct {
 }
 
 // Field function returns field value.
-func (e LocalValidationError) Field() string { return e.field }
+func (e DecisionLogValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LocalValidationError) Reason() string { return e.reason }
+func (e DecisionLogValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LocalValidationError) Cause() error { return e.cause }
+func (e DecisionLogValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LocalValidationError) Key() bool { return e.key }
+func (e DecisionLogValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LocalValidationError) ErrorName() string { return "LocalValidationError" }
+func (e DecisionLogValidationError) ErrorName() string { return "DecisionLogValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LocalValidationError) Error() string {
+func (e DecisionLogValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -287,14 +1238,14 @@ func (e LocalValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLocal.%s: %s%s",
+		"invalid %sDecisionLog.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LocalValidationError{}
+var _ error = DecisionLogValidationError{}
 
 var _ interface {
 	Field() string
@@ -302,7 +1253,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LocalValidationError{}
+} = DecisionLogValidationError{}
 
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -325,6 +1276,37 @@ func (m *Config) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for WithRequestBody
+
+	if all {
+		switch v := interface{}(m.GetDecisionLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DecisionLog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DecisionLog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecisionLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "DecisionLog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	oneofConfigTypePresent := false
 	switch v := m.ConfigType.(type) {
 	case *Config_Remote:
@@ -411,6 +1393,48 @@ func (m *Config) validate(all bool) error {
 			}
 		}
 
+	case *Config_Bundle:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "ConfigType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofConfigTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetBundle()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Bundle",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Bundle",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
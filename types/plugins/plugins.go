@@ -18,6 +18,7 @@ import (
 	_ "mosn.io/htnn/types/dynamicconfigs"
 	_ "mosn.io/htnn/types/plugins/aicontentsecurity"
 	_ "mosn.io/htnn/types/plugins/bandwidthlimit"
+	_ "mosn.io/htnn/types/plugins/basicauth"
 	_ "mosn.io/htnn/types/plugins/buffer"
 	_ "mosn.io/htnn/types/plugins/casbin"
 	_ "mosn.io/htnn/types/plugins/celscript"
@@ -29,6 +30,7 @@ import (
 	_ "mosn.io/htnn/types/plugins/extproc"
 	_ "mosn.io/htnn/types/plugins/fault"
 	_ "mosn.io/htnn/types/plugins/hmacauth"
+	_ "mosn.io/htnn/types/plugins/jwtauth"
 	_ "mosn.io/htnn/types/plugins/keyauth"
 	_ "mosn.io/htnn/types/plugins/limitcountredis"
 	_ "mosn.io/htnn/types/plugins/limitreq"
@@ -36,6 +38,8 @@ import (
 	_ "mosn.io/htnn/types/plugins/listenerpatch"
 	_ "mosn.io/htnn/types/plugins/localratelimit"
 	_ "mosn.io/htnn/types/plugins/lua"
+	_ "mosn.io/htnn/types/plugins/mtlsauth"
+	_ "mosn.io/htnn/types/plugins/multiauth"
 	_ "mosn.io/htnn/types/plugins/networkrbac"
 	_ "mosn.io/htnn/types/plugins/oidc"
 	_ "mosn.io/htnn/types/plugins/opa"
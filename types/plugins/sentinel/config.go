@@ -65,6 +65,10 @@ func (conf *CustomConfig) Validate() error {
 		return err
 	}
 
+	if err = conf.checkCluster(); err != nil {
+		return err
+	}
+
 	if err = conf.checkHotSpot(); err != nil {
 		return err
 	}
@@ -109,6 +113,31 @@ func (conf *CustomConfig) checkFlow() error {
 	return nil
 }
 
+func (conf *CustomConfig) checkCluster() error {
+	flowIDs := make(map[int64]string)
+	for _, r := range conf.GetFlow().GetRules() {
+		c := r.GetCluster()
+		if c == nil {
+			continue
+		}
+
+		if conf.GetCluster() == nil {
+			return fmt.Errorf("wrong config: flow resource %s, 'cluster' must be configured when the rule is a cluster rule", r.GetResource())
+		}
+
+		if r.GetRelationStrategy() == FlowRule_ASSOCIATED_RESOURCE {
+			return fmt.Errorf("wrong config: flow resource %s, cluster rule doesn't support 'relationStrategy' == ASSOCIATED_RESOURCE", r.GetResource())
+		}
+
+		if res, ok := flowIDs[c.GetFlowId()]; ok {
+			return fmt.Errorf("wrong config: flow resource %s, duplicate cluster 'flowId' %d with flow resource %s", r.GetResource(), c.GetFlowId(), res)
+		}
+		flowIDs[c.GetFlowId()] = r.GetResource()
+	}
+
+	return nil
+}
+
 func (conf *CustomConfig) checkHotSpot() error {
 	hs := conf.GetHotSpot()
 	if hs == nil {